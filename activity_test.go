@@ -9,9 +9,16 @@
 package activity
 
 import (
+	"errors"
 	"github.com/dingqinghui/activity/pb"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/types/known/anypb"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -111,7 +118,7 @@ func (p *player) OperateSubCost(activityId int64, items []*pb.ItemData) error {
 	return nil
 }
 
-func (p *player) OperateSendMail(activityId int64, items []*pb.ItemData) error {
+func (p *player) OperateSendMail(mail *pb.OperateMail) error {
 	return nil
 }
 
@@ -161,3 +168,1708 @@ func PlayerActivityDataUpdate(playerId int32, activityId int64, cmd DataCmd, upd
 	default:
 	}
 }
+
+var onceTestEnv sync.Once
+
+//
+// initTestEnv
+// @Description: 初始化测试环境
+//
+func initTestEnv() {
+	onceTestEnv.Do(func() {
+		Init(nil, nil, GetAreaStartTime, WithLogger(zap.NewNop()))
+	})
+}
+
+//
+// testActivityId
+// @Description: 测试活动Id生成
+//
+var testActivityId int64 = 10000
+
+//
+// newTestActivity
+// @Description: 创建进行中的绝对时间测试活动并添加到全局管理器,测试结束后删除
+// @param t
+// @param templates
+// @return *pb.OperateActivity
+//
+func newTestActivity(t *testing.T, templates ...*pb.ActivityTemplate) *pb.OperateActivity {
+	initTestEnv()
+	testActivityId++
+	now := nowTimestamp()
+	conf := &pb.OperateActivity{
+		Id:             testActivityId,
+		TimeType:       pb.OperateActivityTimeType_ABSOLUTE_TIME,
+		PredictionTime: now - 3600,
+		StartTime:      now - 3600,
+		EndTime:        now + 86400,
+		CloseDuration:  now + 2*86400,
+		ActivityList: map[int32]*pb.ActivityList{
+			1: {List: templates},
+		},
+	}
+	Add(conf)
+	t.Cleanup(func() {
+		Delete(conf.GetId())
+	})
+	return conf
+}
+
+//
+// mockPlayer
+// @Description: 测试玩家,记录道具和邮件
+//
+type mockPlayer struct {
+	items map[int32]int32
+	mails []*pb.OperateMail
+}
+
+func newMockPlayer() *mockPlayer {
+	return &mockPlayer{items: make(map[int32]int32)}
+}
+
+func (p *mockPlayer) GetId() int32 {
+	return 2
+}
+func (p *mockPlayer) OperateCheckCost(activityId int64, items []*pb.ItemData) error {
+	for _, item := range items {
+		if p.items[item.GetId()] < item.GetNum() {
+			return errors.New("item not enough")
+		}
+	}
+	return nil
+}
+func (p *mockPlayer) OperateAddReward(activityId int64, items []*pb.ItemData) error {
+	for _, item := range items {
+		p.items[item.GetId()] += item.GetNum()
+	}
+	return nil
+}
+func (p *mockPlayer) OperateSubCost(activityId int64, items []*pb.ItemData) error {
+	if err := p.OperateCheckCost(activityId, items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		p.items[item.GetId()] -= item.GetNum()
+	}
+	return nil
+}
+func (p *mockPlayer) OperateSendMail(mail *pb.OperateMail) error {
+	p.mails = append(p.mails, mail)
+	return nil
+}
+
+func TestNotifications(t *testing.T) {
+	conf := newTestActivity(t,
+		&pb.ActivityTemplate{
+			TemplateType: pb.ActivityTemplateType_CONDITION_TYPE,
+			Condition: &pb.ConditionTemplate{Data: []*pb.Condition{
+				{RewardList: []*pb.ItemData{{Id: 1, Num: 1}}},
+				{RewardList: []*pb.ItemData{{Id: 1, Num: 1}}},
+			}},
+		},
+		&pb.ActivityTemplate{
+			TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+			SignIn: &pb.SignInTemplate{
+				TriggerCondition: true,
+				SignInCount:      7,
+				RewardList:       []*pb.SignInReward{{SignInReward: []*pb.ItemData{{Id: 2, Num: 1}}}},
+			},
+		},
+		&pb.ActivityTemplate{
+			TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+			Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+				{Expend: []*pb.ItemData{{Id: 3, Num: 1}}},
+				{Expend: []*pb.ItemData{{Id: 3, Num: 10}}},
+			}},
+		},
+	)
+	conf.ScoreSystem = []*pb.ScoreTemplate{{Score: &pb.ItemData{Id: 3, Num: 1}}}
+
+	p := newMockPlayer()
+	p.items[3] = 1
+	mgr := NewPlayerActivityMgr(p, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	mgr.TriggerCondition(func(_ *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+		taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+		return true
+	})
+
+	notify := mgr.Notifications()
+	if len(notify.GetList()) != 1 {
+		t.Fatalf("notify list len %d", len(notify.GetList()))
+	}
+	n := notify.GetList()[0]
+	if n.GetScoreCount() != 1 || len(n.GetTemplates()) != 3 {
+		t.Fatalf("unexpected notify %v", n)
+	}
+	if n.GetTemplates()[0].GetTaskCount() != 2 || !n.GetTemplates()[1].GetCanSign() || n.GetTemplates()[2].GetGoodsCount() != 1 {
+		t.Fatalf("unexpected template notify %v", n.GetTemplates())
+	}
+
+	if err := mgr.Sign(conf.GetId(), 1); err != nil {
+		t.Fatal(err)
+	}
+	n = mgr.Notifications().GetList()[0]
+	if n.GetTemplates()[1].GetCanSign() || n.GetTemplates()[1].GetSignRewardCount() != 1 {
+		t.Fatalf("unexpected sign notify %v", n.GetTemplates()[1])
+	}
+}
+
+//
+// mockPusher
+// @Description: 测试客户端推送,记录推送消息
+//
+type mockPusher struct {
+	msgs []proto.Message
+}
+
+func (p *mockPusher) Push(_ int32, msg proto.Message) {
+	p.msgs = append(p.msgs, msg)
+}
+
+func TestClientPusher(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{TriggerCondition: true, SignInCount: 7},
+	})
+
+	pusher := &mockPusher{}
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil, WithClientPusher(pusher))
+	mgr.InitData(nil)
+	if len(pusher.msgs) != 1 {
+		t.Fatalf("push count %d", len(pusher.msgs))
+	}
+	if s2c, ok := pusher.msgs[0].(*pb.OperateNewS2C); !ok || s2c.GetList()[0].GetConf().GetId() != conf.GetId() {
+		t.Fatalf("unexpected new push %v", pusher.msgs[0])
+	}
+
+	if err := mgr.Sign(conf.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if s2c, ok := pusher.msgs[len(pusher.msgs)-1].(*pb.OperateUpdateS2C); !ok || s2c.GetDetailed()[0].GetActivityId() != conf.GetId() {
+		t.Fatalf("unexpected update push %v", pusher.msgs[len(pusher.msgs)-1])
+	}
+
+	Delete(conf.GetId())
+	mgr.CheckNewAndDelete()
+	if s2c, ok := pusher.msgs[len(pusher.msgs)-1].(*pb.OperateDeleteS2C); !ok || s2c.GetActivityId() != conf.GetId() {
+		t.Fatalf("unexpected delete push %v", pusher.msgs[len(pusher.msgs)-1])
+	}
+}
+
+func TestHandle(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{TriggerCondition: true, SignInCount: 7},
+	})
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	data, _ := proto.Marshal(&pb.OperateSignC2S{ActivityId: conf.GetId()})
+	msg, err := mgr.HandleBytes("OperateSignC2S", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2c, ok := msg.(*pb.OperateSignS2C); !ok || s2c.GetActivityId() != conf.GetId() {
+		t.Fatalf("unexpected s2c %v", msg)
+	}
+	// 重复签到
+	if _, err = mgr.Handle(&pb.OperateSignC2S{ActivityId: conf.GetId()}); err == nil {
+		t.Fatal("sign twice")
+	}
+	if _, err = mgr.Handle(&pb.OperateSignS2C{}); err == nil {
+		t.Fatal("handle s2c msg")
+	}
+	// 非法模板索引
+	msg, err = mgr.Handle(&pb.OperateSignC2S{ActivityId: conf.GetId(), TplIndex: -1})
+	if ErrorCode(err) != pb.OperateErrorCode_OEC_TEMPLATE_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if s2c, ok := msg.(*pb.OperateSignS2C); !ok || s2c.GetCode() != pb.OperateErrorCode_OEC_TEMPLATE_NOT_EXIST {
+		t.Fatalf("unexpected s2c %v", msg)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+		Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+			{IsLimit: true, LimitCount: 1, Expend: []*pb.ItemData{{Id: 3, Num: 1}}},
+		}},
+	})
+	p := newMockPlayer()
+	p.items[3] = 10
+	mgr := NewPlayerActivityMgr(p, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	if err := mgr.ShopBuyGoods(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	err := mgr.ShopBuyGoods(conf.GetId(), 0, 0)
+	if ErrorCode(err) != pb.OperateErrorCode_OEC_SHOP_GOODS_LIMIT || !errors.Is(err, goodsLimit) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	msg, _ := mgr.Handle(&pb.OperateShopBuyC2S{ActivityId: conf.GetId(), GoodsIndex: 0})
+	if msg.(*pb.OperateShopBuyS2C).GetCode() != pb.OperateErrorCode_OEC_SHOP_GOODS_LIMIT {
+		t.Fatalf("unexpected s2c %v", msg)
+	}
+	if ErrorCode(mgr.Sign(-1, 0)) != pb.OperateErrorCode_OEC_ACTIVITY_NOT_EXIST {
+		t.Fatal("activity not exist code")
+	}
+}
+
+func TestLedger(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+		Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+			{Goods: []*pb.ItemData{{Id: 1, Num: 1}}, Expend: []*pb.ItemData{{Id: 3, Num: 1}}},
+		}},
+	})
+	fileLedger, err := NewFileLedger(filepath.Join(t.TempDir(), "ledger.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fileLedger.Close()
+
+	for _, sink := range []interface {
+		LedgerSink
+		LedgerQuerier
+	}{NewMemoryLedger(), fileLedger} {
+		SetLedgerSink(sink)
+		p := newMockPlayer()
+		p.items[3] = 2
+		mgr := NewPlayerActivityMgr(p, 101, 10001, nowTimestamp(), nil)
+		mgr.InitData(nil)
+		mgr.SetRequestId("req-1")
+		if err = mgr.ShopBuyGoods(conf.GetId(), 0, 0); err != nil {
+			t.Fatal(err)
+		}
+		// 请求Id只对下一次操作有效
+		if err = mgr.ShopBuyGoods(conf.GetId(), 0, 0); err != nil {
+			t.Fatal(err)
+		}
+		records, err := sink.Query(p.GetId(), conf.GetId())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != 4 || records[0].Op != LedgerOpCost || records[1].Op != LedgerOpGrant {
+			t.Fatalf("unexpected records %v", records)
+		}
+		if records[1].Source != LedgerSourceShopBuy || records[1].RequestId != "req-1" || records[1].Items[0].GetId() != 1 {
+			t.Fatalf("unexpected record %+v", records[1])
+		}
+		if records[3].RequestId != "" {
+			t.Fatalf("unexpected record %+v", records[3])
+		}
+	}
+	SetLedgerSink(nil)
+}
+
+func TestMetrics(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{TriggerCondition: true, SignInCount: 7},
+	})
+	registry := NewRegistry()
+	SetMetricsRegistry(registry)
+	defer SetMetricsRegistry(NewRegistry())
+
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	_ = mgr.Sign(conf.GetId(), 0)
+	_ = mgr.Sign(conf.GetId(), 0)
+
+	var b strings.Builder
+	if err := registry.WritePrometheus(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	activity := strconv.FormatInt(conf.GetId(), 10)
+	for _, line := range []string{
+		`activity_operation_total{activity="` + activity + `",code="OEC_SUCCESS",op="sign"} 1`,
+		`activity_operation_errors_total{code="OEC_SIGN_TODAY_SIGNED",op="sign"} 1`,
+		`activity_check_new_and_delete_seconds_count 1`,
+		`# TYPE activity_check_new_and_delete_seconds histogram`,
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("missing %q in\n%s", line, out)
+		}
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{TriggerCondition: true, SignInCount: 7},
+	})
+	maintain := errors.New("maintain")
+	var observed []error
+	var blocked bool
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil,
+		WithInterceptors(func(mgr *PlayerActivityMgr, op *Operation, next OperationHandler) error {
+			err := next(op)
+			observed = append(observed, err)
+			return err
+		}, func(mgr *PlayerActivityMgr, op *Operation, next OperationHandler) error {
+			if blocked && op.Type == OpSign {
+				return maintain
+			}
+			return next(op)
+		}))
+	mgr.InitData(nil)
+
+	blocked = true
+	if err := mgr.Sign(conf.GetId(), 0); err != maintain {
+		t.Fatalf("unexpected error %v", err)
+	}
+	blocked = false
+	if err := mgr.Sign(conf.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(observed) != 2 || observed[0] != maintain || observed[1] != nil {
+		t.Fatalf("unexpected observed %v", observed)
+	}
+}
+
+func TestLottery(t *testing.T) {
+	SetRewardPoolFun(func(poolId int32) []*pb.RewardPool {
+		return []*pb.RewardPool{{Reward: &pb.ItemData{Id: 10, Num: 1}, WeightValue: 1}}
+	})
+	defer SetRewardPoolFun(nil)
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_LOTTERY_TYPE,
+		Lottery: &pb.LotteryTemplate{
+			CostItemId:      100,
+			GuaranteedCount: 3,
+			GuaranteedItem:  &pb.ItemData{Id: 20, Num: 1},
+			RewardPoolId:    1,
+			LotteryList: []*pb.Lottery{
+				{SingleCost: 10, FirstSingleDiscount: 50},
+				{SingleCost: 10, MultiCount: 10, MultiDiscount: 90},
+			},
+		},
+	})
+	player := newMockPlayer()
+	player.items[100] = 100
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	// 首次单抽5折
+	if _, err := mgr.Draw(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[100] != 95 {
+		t.Fatalf("unexpected cost %d", 100-player.items[100])
+	}
+	if _, err := mgr.Draw(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	// 第三抽触发保底
+	rewards, err := mgr.Draw(conf.GetId(), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rewards) != 1 || rewards[0].GetId() != 20 {
+		t.Fatalf("unexpected guaranteed rewards %v", rewards)
+	}
+	// 多抽9折,消耗不足
+	if _, err = mgr.Draw(conf.GetId(), 0, 1); ErrorCode(err) != pb.OperateErrorCode_OEC_COST_NOT_ENOUGH {
+		t.Fatalf("unexpected error %v", err)
+	}
+	player.items[100] = 90
+	if rewards, err = mgr.Draw(conf.GetId(), 0, 1); err != nil || len(rewards) != 10 {
+		t.Fatalf("unexpected multi draw %v %v", rewards, err)
+	}
+	if player.items[20] != 4 || player.items[10] != 9 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	if _, err = mgr.Draw(conf.GetId(), 0, 2); ErrorCode(err) != pb.OperateErrorCode_OEC_LOTTERY_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 发放失败退还消耗
+	failPlayer := &failItemPlayer{mockPlayer: newMockPlayer(), failId: 10}
+	failPlayer.items[100] = 100
+	failMgr := NewPlayerActivityMgr(failPlayer, 101, 10001, nowTimestamp(), nil)
+	failMgr.InitData(nil)
+	if _, err = failMgr.Draw(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if failPlayer.items[100] != 100 {
+		t.Fatalf("unexpected items %v", failPlayer.items)
+	}
+}
+
+// testCustomTemplateType 测试自定义模板类型
+const testCustomTemplateType = pb.ActivityTemplateType(1000)
+
+//
+// testCustomTemplate
+// @Description: 测试自定义模板,配置为奖励,存档记录领取次数
+//
+type testCustomTemplate struct {
+	*BaseTemplate
+	reward *pb.ItemData
+	data   *pb.ItemData
+}
+
+func (m *testCustomTemplate) GetNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	if m.data.GetNum() > 0 {
+		return nil
+	}
+	return &pb.OperateNotifyTemplate{}
+}
+
+func (m *testCustomTemplate) claim() error {
+	if err := m.AddReward(LedgerSource("custom_claim"), 0, []*pb.ItemData{m.reward}); err != nil {
+		return err
+	}
+	m.data.Num++
+	return m.SaveData(m.data)
+}
+
+func TestCustomTemplate(t *testing.T) {
+	RegisterTemplate(testCustomTemplateType, func(base *BaseTemplate) Template {
+		result := &testCustomTemplate{BaseTemplate: base, reward: &pb.ItemData{}, data: &pb.ItemData{}}
+		if err := base.UnmarshalConf(result.reward); err != nil {
+			return nil
+		}
+		if err := base.LoadData(result.data); err != nil {
+			return nil
+		}
+		return result
+	})
+	ext, _ := anypb.New(proto.MessageV2(&pb.ItemData{Id: 1, Num: 5}))
+	conf := newTestActivity(t, &pb.ActivityTemplate{TemplateType: testCustomTemplateType, Extension: ext})
+
+	var saved *pb.OperateActivityDB
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), func(playerId int32, activityId int64, cmd DataCmd, updateInfo *pb.OperateActivityDB) {
+		saved = updateInfo
+	})
+	mgr.InitData(nil)
+	if len(mgr.Notifications().GetList()[0].GetTemplates()) != 1 {
+		t.Fatalf("unexpected notify %v", mgr.Notifications())
+	}
+
+	template, err := mgr.GetTemplate(conf.GetId(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = template.(*testCustomTemplate).claim(); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[1] != 5 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	data := &pb.ItemData{}
+	ext = saved.GetActivityList()[1].GetList()[0].GetExtension()
+	if err = ext.UnmarshalTo(proto.MessageV2(data)); err != nil || data.GetNum() != 1 {
+		t.Fatalf("unexpected saved data %v %v", data, err)
+	}
+	if len(mgr.Notifications().GetList()[0].GetTemplates()) != 0 {
+		t.Fatalf("unexpected notify %v", mgr.Notifications())
+	}
+	if _, err = mgr.GetTemplate(conf.GetId(), 1); ErrorCode(err) != pb.OperateErrorCode_OEC_TEMPLATE_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestShopPrice(t *testing.T) {
+	now := nowTimestamp()
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+		Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+			{
+				Goods:      []*pb.ItemData{{Id: 1, Num: 1}},
+				Expend:     []*pb.ItemData{{Id: 100, Num: 15}},
+				Discount:   80,
+				PriceSteps: []int32{100, 150},
+			},
+			{
+				Goods:       []*pb.ItemData{{Id: 1, Num: 1}},
+				Expend:      []*pb.ItemData{{Id: 100, Num: 10}},
+				Discount:    90,
+				SaleWindows: []*pb.SaleWindow{{StartTime: now - 60, EndTime: now + 60, Discount: 55}},
+			},
+		}},
+	})
+	player := newMockPlayer()
+	player.items[100] = 100
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	// 15*80% = 12, 15*150%*80% = 18
+	for _, expect := range []int32{12, 18, 18} {
+		price, err := mgr.GetGoodsPrice(conf.GetId(), 0, 0)
+		if err != nil || price.GetExpend()[0].GetNum() != expect {
+			t.Fatalf("unexpected price %v %v expect %d", price, err, expect)
+		}
+		if err = mgr.ShopBuyGoods(conf.GetId(), 0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if player.items[100] != 100-12-18-18 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+
+	// 限时折扣 10*55% = 5.5 向上取整
+	s2c := mgr.PackOneActivity(conf.GetId())
+	price := s2c.GetList()[0].GetTemplates()[0].GetGoodsPrices()[1]
+	if price.GetExpend()[0].GetNum() != 6 || price.GetDiscount() != 55 || price.GetSaleEndTime() != now+60 {
+		t.Fatalf("unexpected sale price %v", price)
+	}
+}
+
+func TestShopRefresh(t *testing.T) {
+	goods := func(refreshType pb.TaskRefreshType) *pb.ExchangeGoods {
+		return &pb.ExchangeGoods{
+			Goods:       []*pb.ItemData{{Id: 1, Num: 1}},
+			IsLimit:     true,
+			LimitCount:  1,
+			RefreshType: refreshType,
+		}
+	}
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+		Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+			goods(pb.TaskRefreshType_TRT_DAY), goods(pb.TaskRefreshType_TRT_WEEK), goods(pb.TaskRefreshType_TRT_NOT),
+		}},
+	})
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	for i := 0; i < 3; i++ {
+		if err := mgr.ShopBuyGoods(conf.GetId(), 0, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mgr.OnNewDay()
+	expect := []pb.OperateErrorCode{pb.OperateErrorCode_OEC_SUCCESS, pb.OperateErrorCode_OEC_SHOP_GOODS_LIMIT, pb.OperateErrorCode_OEC_SHOP_GOODS_LIMIT}
+	for i, code := range expect {
+		if err := mgr.ShopBuyGoods(conf.GetId(), 0, i); ErrorCode(err) != code {
+			t.Fatalf("goods %d unexpected error %v", i, err)
+		}
+	}
+	mgr.OnNewWeek()
+	if err := mgr.ShopBuyGoods(conf.GetId(), 0, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+//
+// failRewardPlayer
+// @Description: 发放奖励失败的测试玩家
+//
+type failRewardPlayer struct {
+	*mockPlayer
+}
+
+func (p *failRewardPlayer) OperateAddReward(activityId int64, items []*pb.ItemData) error {
+	return errors.New("add reward fail")
+}
+
+//
+// failItemPlayer
+// @Description: 发放指定道具失败的测试玩家
+//
+type failItemPlayer struct {
+	*mockPlayer
+	failId int32
+}
+
+func (p *failItemPlayer) OperateAddReward(activityId int64, items []*pb.ItemData) error {
+	for _, item := range items {
+		if item.GetId() == p.failId {
+			return errors.New("add reward fail")
+		}
+	}
+	return p.mockPlayer.OperateAddReward(activityId, items)
+}
+
+func TestShopStock(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONSUMPTION_TYPE,
+		Consumption: &pb.ConsumptionTemplate{SellGoods: []*pb.ExchangeGoods{
+			{Goods: []*pb.ItemData{{Id: 1, Num: 1}}, Expend: []*pb.ItemData{{Id: 3, Num: 1}}, Stock: 1},
+		}},
+	})
+	failPlayer := &failItemPlayer{mockPlayer: newMockPlayer(), failId: 1}
+	failPlayer.items[3] = 1
+	failMgr := NewPlayerActivityMgr(failPlayer, 101, 10001, nowTimestamp(), nil)
+	failMgr.InitData(nil)
+	player := newMockPlayer()
+	player.items[3] = 1
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	// 发放失败退还消耗并归还库存
+	if err := failMgr.ShopBuyGoods(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if failPlayer.items[3] != 1 {
+		t.Fatalf("unexpected items %v", failPlayer.items)
+	}
+	if price, _ := mgr.GetGoodsPrice(conf.GetId(), 0, 0); price.GetRemainStock() != 1 {
+		t.Fatalf("unexpected stock %v", price)
+	}
+	if err := mgr.ShopBuyGoods(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := failMgr.ShopBuyGoods(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_SHOP_STOCK_NOT_ENOUGH {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if price, _ := mgr.GetGoodsPrice(conf.GetId(), 0, 0); price.GetRemainStock() != 0 {
+		t.Fatalf("unexpected stock %v", price)
+	}
+}
+
+func TestSignAutoGetReward(t *testing.T) {
+	rewards := []*pb.SignInReward{
+		{SignInReward: []*pb.ItemData{{Id: 1, Num: 1}}},
+		{SignInReward: []*pb.ItemData{{Id: 1, Num: 2}}},
+	}
+	auto := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{SignInCount: 7, IsAutoGetReward: true, RewardList: rewards},
+	})
+	manual := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn:       &pb.SignInTemplate{SignInCount: 7, TriggerCondition: true, RewardList: rewards},
+	})
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	// 登录触发签到自动发奖
+	if err := mgr.Login(); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[1] != 1 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	if err := mgr.SignGetReward(auto.GetId(), 0, 1); ErrorCode(err) != pb.OperateErrorCode_OEC_SIGN_REWARD_GOT {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// 手动模式签到不发奖
+	if err := mgr.Sign(manual.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[1] != 1 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	if err := mgr.SignGetReward(manual.GetId(), 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[1] != 2 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+
+	// 补签奖励发放失败退还补签消耗
+	repair := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn: &pb.SignInTemplate{
+			SignInCount:               7,
+			IsAutoGetReward:           true,
+			RepairSignInCount:         1,
+			EveryDayRepairSignInCount: 1,
+			RepairSignIn:              []*pb.RepairSignInRule{{RSI_Expend: []*pb.ItemData{{Id: 60, Num: 1}}}},
+			RewardList:                rewards,
+		},
+	})
+	repair.StartTime = nowTimestamp() - 2*86400
+	failPlayer := &failItemPlayer{mockPlayer: newMockPlayer(), failId: 1}
+	failPlayer.items[60] = 1
+	failMgr := NewPlayerActivityMgr(failPlayer, 101, 10001, nowTimestamp(), nil)
+	failMgr.InitData(nil)
+	if err := failMgr.SignRepair(repair.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if failPlayer.items[60] != 1 {
+		t.Fatalf("unexpected items %v", failPlayer.items)
+	}
+}
+
+func TestSignStreak(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn: &pb.SignInTemplate{
+			TriggerCondition:   true,
+			Mode:               pb.SignInMode_SIM_STREAK,
+			StreakGraceDays:    1,
+			StreakDecay:        2,
+			StreakFreezeItemId: 50,
+			StreakCycle:        true,
+			RewardList: []*pb.SignInReward{
+				{SignInReward: []*pb.ItemData{{Id: 1, Num: 1}}},
+				{SignInReward: []*pb.ItemData{{Id: 2, Num: 1}}},
+				{SignInReward: []*pb.ItemData{{Id: 3, Num: 1}}},
+			},
+		},
+	})
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	template := mgr.getActivity(conf.GetId()).getSignTemplate(0)
+
+	// lastDays 距上次签到天数, expect 签到后连续天数
+	steps := []struct {
+		lastDays int64
+		freeze   int32
+		expect   int32
+	}{
+		{0, 0, 1},
+		{2, 0, 2}, // 断签1天在宽限内
+		{4, 0, 1}, // 断签3天超出宽限2天,衰减4天后清零
+		{4, 2, 2}, // 保护道具抵消断签
+		{1, 0, 3},
+		{1, 0, 4}, // 循环发放第一天奖励
+	}
+	for i, step := range steps {
+		if step.lastDays > 0 {
+			template.getSignData().LastSignTimestamp = nowTimestamp() - step.lastDays*86400
+		}
+		player.items[50] = step.freeze
+		if err := mgr.Sign(conf.GetId(), 0); err != nil {
+			t.Fatalf("step %d %v", i, err)
+		}
+		if template.getSignData().GetStreak() != step.expect {
+			t.Fatalf("step %d unexpected streak %d", i, template.getSignData().GetStreak())
+		}
+	}
+	if player.items[1] != 3 || player.items[2] != 2 || player.items[3] != 1 || player.items[50] != 0 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	if template.getSignData().GetMaxStreak() != 4 {
+		t.Fatalf("unexpected max streak %d", template.getSignData().GetMaxStreak())
+	}
+
+	template.getSignData().LastSignTimestamp = nowTimestamp() - 3*86400
+	if streak := mgr.PackOneActivity(conf.GetId()).GetList()[0].GetTemplates()[0].GetStreak(); streak != 2 {
+		t.Fatalf("unexpected client streak %d", streak)
+	}
+	if err := mgr.SignGetReward(conf.GetId(), 0, 1); ErrorCode(err) != pb.OperateErrorCode_OEC_SIGN_MODE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// 奖励发放失败退还保护道具
+	failPlayer := &failItemPlayer{mockPlayer: newMockPlayer(), failId: 1}
+	failMgr := NewPlayerActivityMgr(failPlayer, 101, 10001, nowTimestamp(), nil)
+	failMgr.InitData(nil)
+	failTemplate := failMgr.getActivity(conf.GetId()).getSignTemplate(0)
+	failTemplate.getSignData().Streak = 5
+	failTemplate.getSignData().LastSignTimestamp = nowTimestamp() - 4*86400
+	failPlayer.items[50] = 1
+	if err := failMgr.Sign(conf.GetId(), 0); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if failPlayer.items[50] != 1 {
+		t.Fatalf("unexpected items %v", failPlayer.items)
+	}
+	// 道具不足时按持有数量保护,断签2天保护1天
+	failPlayer.failId = 0
+	if err := failMgr.Sign(conf.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if failTemplate.getSignData().GetStreak() != 4 || failPlayer.items[50] != 0 {
+		t.Fatalf("unexpected streak %d items %v", failTemplate.getSignData().GetStreak(), failPlayer.items)
+	}
+}
+
+func TestSignCalendar(t *testing.T) {
+	// 奖励道具Id等于当月日期
+	var rewards []*pb.SignInReward
+	for day := int32(1); day <= 31; day++ {
+		rewards = append(rewards, &pb.SignInReward{SignInReward: []*pb.ItemData{{Id: day, Num: 1}}})
+	}
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn: &pb.SignInTemplate{
+			TriggerCondition:          true,
+			Mode:                      pb.SignInMode_SIM_CALENDAR,
+			RepairSignInCount:         5,
+			EveryDayRepairSignInCount: 5,
+			RewardList:                rewards,
+		},
+	})
+	conf.StartTime = nowTimestamp() - 40*86400
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+
+	today := calendarDate(nowTimestamp())
+	if err := mgr.Sign(conf.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.SignGetReward(conf.GetId(), 0, today); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[today%100] != 1 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	if err := mgr.SignRepair(conf.GetId(), 0, today); ErrorCode(err) != pb.OperateErrorCode_OEC_SIGN_DATE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if client := mgr.PackOneActivity(conf.GetId()).GetList()[0].GetTemplates()[0]; client.GetToday() != today {
+		t.Fatalf("unexpected client data %v", client)
+	}
+
+	// 每月1号没有可补签日期
+	if today%100 == 1 {
+		return
+	}
+	if err := mgr.SignRepair(conf.GetId(), 0, today-1); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.SignRepair(conf.GetId(), 0, today-1); ErrorCode(err) != pb.OperateErrorCode_OEC_SIGN_DATE_SIGNED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.SignGetReward(conf.GetId(), 0, today-1); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[today%100-1] != 1 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+
+	// 自动领奖补签奖励发放失败退还补签消耗
+	auto := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn: &pb.SignInTemplate{
+			Mode:                      pb.SignInMode_SIM_CALENDAR,
+			IsAutoGetReward:           true,
+			RepairSignInCount:         5,
+			EveryDayRepairSignInCount: 5,
+			RepairSignIn:              []*pb.RepairSignInRule{{RSI_Expend: []*pb.ItemData{{Id: 60, Num: 1}}}},
+			RewardList:                rewards,
+		},
+	})
+	auto.StartTime = nowTimestamp() - 40*86400
+	failPlayer := &failItemPlayer{mockPlayer: newMockPlayer(), failId: today%100 - 1}
+	failPlayer.items[60] = 1
+	failMgr := NewPlayerActivityMgr(failPlayer, 101, 10001, nowTimestamp(), nil)
+	failMgr.InitData(nil)
+	if err := failMgr.SignRepair(auto.GetId(), 0, today-1); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if failPlayer.items[60] != 1 {
+		t.Fatalf("unexpected items %v", failPlayer.items)
+	}
+
+	// 注册时间活动不能补签注册前的日期
+	if today%100 < 3 {
+		return
+	}
+	relative := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_SIGN_IN_TYPE,
+		SignIn: &pb.SignInTemplate{
+			Mode:                      pb.SignInMode_SIM_CALENDAR,
+			RepairSignInCount:         5,
+			EveryDayRepairSignInCount: 5,
+			RewardList:                rewards,
+		},
+	})
+	relative.TimeType = pb.OperateActivityTimeType_REGISTER_TIME
+	relative.PredictionTime, relative.StartTime, relative.EndTime, relative.CloseDuration = 0, 0, 86400*30, 86400*31
+	relativeMgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp()-86400, nil)
+	relativeMgr.InitData(nil)
+	if err := relativeMgr.SignRepair(relative.GetId(), 0, today-2); ErrorCode(err) != pb.OperateErrorCode_OEC_SIGN_DATE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestPreCondition(t *testing.T) {
+	groups := []*pb.ConditionGroup{
+		{PreCondition: []*pb.Condition{{Condition: 1}}},
+		{PreCondition: []*pb.Condition{{Condition: 2}, {Condition: 3}}},
+	}
+	leaf := func(group, index int32) *pb.PreCondNode {
+		return &pb.PreCondNode{Type: pb.PreCondNodeType_PCN_LEAF, Group: group, Index: index}
+	}
+	anyConf := newTestActivity(t)
+	anyConf.PreConditionGroup = groups
+	allConf := newTestActivity(t)
+	allConf.PreConditionGroup = groups
+	allConf.NeedPreCondAllFinished = true
+	// 任务2,3至少完成一个,且任务1未完成
+	treeConf := newTestActivity(t)
+	treeConf.PreConditionGroup = groups
+	treeConf.PreCondTree = &pb.PreCondNode{Type: pb.PreCondNodeType_PCN_AND, Children: []*pb.PreCondNode{
+		{Type: pb.PreCondNodeType_PCN_AT_LEAST, Count: 1, Children: []*pb.PreCondNode{leaf(1, 0), leaf(1, 1)}},
+		{Type: pb.PreCondNodeType_PCN_NOT, Children: []*pb.PreCondNode{leaf(0, 0)}},
+	}}
+
+	mgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	finish := func(condition int32) {
+		mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			if conf.GetCondition() != condition {
+				return false
+			}
+			taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+			return true
+		})
+	}
+	check := func(step string, expect map[*pb.OperateActivity]bool) {
+		for conf, finished := range expect {
+			if mgr.getActivity(conf.GetId()).finishedPreCondition() != finished {
+				t.Fatalf("%s activity %d expect %v", step, conf.GetId(), finished)
+			}
+		}
+	}
+
+	check("init", map[*pb.OperateActivity]bool{anyConf: false, allConf: false, treeConf: false})
+	finish(2)
+	check("finish 2", map[*pb.OperateActivity]bool{anyConf: false, allConf: false, treeConf: true})
+	progress := mgr.PackOneActivity(treeConf.GetId()).GetList()[0].GetPreCond()
+	if atLeast := progress.GetChildren()[0]; atLeast.GetFinishedCount() != 1 || atLeast.GetNeedCount() != 1 {
+		t.Fatalf("unexpected progress %v", progress)
+	}
+	finish(1)
+	check("finish 1", map[*pb.OperateActivity]bool{anyConf: true, allConf: false, treeConf: false})
+	finish(3)
+	check("finish 3", map[*pb.OperateActivity]bool{anyConf: true, allConf: true, treeConf: false})
+	progress = mgr.PackOneActivity(allConf.GetId()).GetList()[0].GetPreCond()
+	if progress.GetFinishedCount() != 2 || progress.GetNeedCount() != 2 || progress.GetChildren()[1].GetFinishedCount() != 2 {
+		t.Fatalf("unexpected progress %v", progress)
+	}
+}
+
+func TestActivityDependency(t *testing.T) {
+	confA := newTestActivity(t)
+	confA.ScoreSystem = []*pb.ScoreTemplate{
+		{Score: &pb.ItemData{Id: 3, Num: 1}, Reward: []*pb.ItemData{{Id: 1, Num: 1}}},
+		{Score: &pb.ItemData{Id: 3, Num: 1}, Reward: []*pb.ItemData{{Id: 1, Num: 1}}},
+	}
+	confB := newTestActivity(t)
+	confB.Dependencies = []*pb.ActivityDependency{{ActivityId: confA.GetId(), Type: pb.ActivityDependType_ADT_FINAL_SCORE}}
+	confC := newTestActivity(t)
+	confC.Dependencies = []*pb.ActivityDependency{{ActivityId: confA.GetId(), Type: pb.ActivityDependType_ADT_COMPLETE}}
+
+	var saved *pb.OperatePlayerDB
+	player := newMockPlayer()
+	player.items[3] = 1
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil,
+		WithActivityRecord(nil, func(_ int32, record *pb.OperatePlayerDB) { saved = record }))
+	mgr.InitData(nil)
+	check := func(step string, mgr *PlayerActivityMgr, expect map[*pb.OperateActivity]bool) {
+		for conf, exist := range expect {
+			if (mgr.getActivity(conf.GetId()) != nil) != exist {
+				t.Fatalf("%s activity %d expect exist %v", step, conf.GetId(), exist)
+			}
+		}
+	}
+
+	check("init", mgr, map[*pb.OperateActivity]bool{confA: true, confB: false, confC: false})
+	if err := mgr.GetScoreReward(confA.GetId(), 1); err != nil {
+		t.Fatal(err)
+	}
+	check("final score", mgr, map[*pb.OperateActivity]bool{confB: true, confC: false})
+	if err := mgr.GetScoreReward(confA.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	check("complete", mgr, map[*pb.OperateActivity]bool{confB: true, confC: true})
+
+	// 删除依赖活动后通过记录判断
+	Delete(confA.GetId())
+	mgr.CheckNewAndDelete()
+	if !saved.GetRecords()[confA.GetId()].GetCompleted() {
+		t.Fatalf("unexpected record %v", saved)
+	}
+	reload := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil, WithActivityRecord(saved, nil))
+	reload.InitData(nil)
+	check("reload", reload, map[*pb.OperateActivity]bool{confA: false, confB: true, confC: true})
+	noRecord := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	noRecord.InitData(nil)
+	check("no record", noRecord, map[*pb.OperateActivity]bool{confB: false, confC: false})
+}
+
+//
+// idPlayer
+// @Description: 指定Id的测试玩家
+//
+type idPlayer struct {
+	*mockPlayer
+	id int32
+}
+
+func (p *idPlayer) GetId() int32 {
+	return p.id
+}
+
+func TestRanking(t *testing.T) {
+	rankingTpl := &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_RANKING_TYPE,
+		Ranking: &pb.RankingTemplate{
+			MinScore: 10,
+			RewardList: []*pb.RankReward{
+				{MinRank: 1, MaxRank: 1, Reward: []*pb.ItemData{{Id: 1, Num: 10}}},
+				{MinRank: 2, MaxRank: 3, Reward: []*pb.ItemData{{Id: 1, Num: 5}}},
+			},
+		},
+	}
+	conf := newTestActivity(t, rankingTpl)
+	players := make([]*idPlayer, 0, 3)
+	mgrs := make([]*PlayerActivityMgr, 0, 3)
+	for i, score := range []int64{50, 80, 5} {
+		player := &idPlayer{mockPlayer: newMockPlayer(), id: int32(11 + i)}
+		mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+		mgr.InitData(nil)
+		if err := mgr.SubmitRankScore(conf.GetId(), 0, score); err != nil {
+			t.Fatal(err)
+		}
+		players = append(players, player)
+		mgrs = append(mgrs, mgr)
+	}
+
+	// 低于上榜积分不上榜
+	page, err := mgrs[2].GetRankList(conf.GetId(), 0, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.GetTotal() != 2 || len(page.GetItems()) != 1 || page.GetItems()[0].GetPlayerId() != 11 ||
+		page.GetItems()[0].GetRank() != 2 || page.GetSelfRank() != 0 || page.GetSelfScore() != 5 {
+		t.Fatalf("unexpected page %v", page)
+	}
+
+	// 每页数量超过上限时按上限返回
+	SetMaxRankPageSize(1)
+	page, err = mgrs[2].GetRankList(conf.GetId(), 0, 1, 1000)
+	SetMaxRankPageSize(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.GetItems()) != 1 || page.GetItems()[0].GetPlayerId() != 12 {
+		t.Fatalf("unexpected page %v", page)
+	}
+
+	// 活动结束后结算,只结算一次
+	conf.EndTime = nowTimestamp() - 1
+	if err := mgrs[0].SubmitRankScore(conf.GetId(), 0, 100); ErrorCode(err) != pb.OperateErrorCode_OEC_ACTIVITY_NOT_OPEN {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, mgr := range mgrs {
+		mgr.settleRanking()
+	}
+	if rank := mgrs[1].getActivity(conf.GetId()).getRankingTemplate(0).getRankingData().GetSettleRank(); rank != 1 {
+		t.Fatalf("unexpected settle rank %d", rank)
+	}
+	for _, mgr := range mgrs {
+		mgr.CheckNewAndDelete()
+	}
+	for i, expect := range []int32{5, 10, 0} {
+		mails := players[i].mails
+		if expect == 0 && len(mails) != 0 || expect != 0 && (len(mails) != 1 || mails[0].GetItems()[0].GetNum() != expect) {
+			t.Fatalf("player %d unexpected mails %v", players[i].GetId(), mails)
+		}
+	}
+
+	// 注册时间活动按玩家注册时间计算结束时间,结束前不结算
+	relative := newTestActivity(t, rankingTpl)
+	relative.TimeType = pb.OperateActivityTimeType_REGISTER_TIME
+	relative.PredictionTime, relative.StartTime, relative.EndTime, relative.CloseDuration = 0, 0, 86400, 2*86400
+	player := &idPlayer{mockPlayer: newMockPlayer(), id: 14}
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp()-3600, nil)
+	mgr.InitData(nil)
+	if err := mgr.SubmitRankScore(relative.GetId(), 0, 50); err != nil {
+		t.Fatal(err)
+	}
+	mgr.CheckNewAndDelete()
+	if activity := mgr.getActivity(relative.GetId()); activity == nil || activity.getRankingTemplate(0).getRankingData().GetSettled() || len(player.mails) != 0 {
+		t.Fatalf("unexpected settle before end, mails %v", player.mails)
+	}
+	relative.EndTime = 3600 - 1
+	mgr.CheckNewAndDelete()
+	if len(player.mails) != 1 || player.mails[0].GetItems()[0].GetNum() != 10 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+}
+
+func TestRankingOfflineSettle(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_RANKING_TYPE,
+		Ranking: &pb.RankingTemplate{
+			RewardList: []*pb.RankReward{
+				{MinRank: 1, MaxRank: 1, Reward: []*pb.ItemData{{Id: 1, Num: 10}}},
+			},
+		},
+	})
+	mgr := NewPlayerActivityMgr(&idPlayer{mockPlayer: newMockPlayer(), id: 21}, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	if err := mgr.SubmitRankScore(conf.GetId(), 0, 50); err != nil {
+		t.Fatal(err)
+	}
+	db := map[int64]*pb.OperateActivityDB{conf.GetId(): mgr.getActivity(conf.GetId()).getDbData()}
+
+	// 玩家离线时活动结束,全局活动过期
+	conf.EndTime = nowTimestamp() - 1
+	RangeAll(func(*pb.OperateActivity) {})
+	if GetActivity(conf.GetId()) != nil {
+		t.Fatal("activity not expired")
+	}
+
+	// 关闭前登录,按保留的配置和排行榜结算
+	player := &idPlayer{mockPlayer: newMockPlayer(), id: 21}
+	mgr = NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(db)
+	if len(player.mails) != 1 || player.mails[0].GetItems()[0].GetNum() != 10 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+	if mgr.getActivity(conf.GetId()) != nil {
+		t.Fatal("activity not deleted")
+	}
+}
+
+func TestRankingDelete(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_RANKING_TYPE,
+		Ranking: &pb.RankingTemplate{
+			RewardList: []*pb.RankReward{
+				{MinRank: 1, MaxRank: 1, Reward: []*pb.ItemData{{Id: 1, Num: 10}}},
+			},
+		},
+	})
+	player := &idPlayer{mockPlayer: newMockPlayer(), id: 22}
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	if err := mgr.SubmitRankScore(conf.GetId(), 0, 50); err != nil {
+		t.Fatal(err)
+	}
+
+	// 结束后删除活动,玩家之后删除时仍按排行榜结算
+	conf.EndTime = nowTimestamp() - 1
+	Delete(conf.GetId())
+	mgr.CheckNewAndDelete()
+	if len(player.mails) != 1 || player.mails[0].GetItems()[0].GetNum() != 10 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+}
+
+//
+// guildPlayer
+// @Description: 公会测试玩家
+//
+type guildPlayer struct {
+	*idPlayer
+	guildId int64
+}
+
+func (p *guildPlayer) GetGuildId() int64 {
+	return p.guildId
+}
+
+func TestGuild(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_GUILD_TYPE,
+		Guild: &pb.GuildTemplate{Tasks: []*pb.GuildTask{
+			{Task: &pb.Condition{Condition: 1, RewardList: []*pb.ItemData{{Id: 1, Num: 1}}}, Target: 10, MinContribution: 3},
+		}},
+	})
+	newGuildMgr := func(id int32, guildId int64) (*guildPlayer, *PlayerActivityMgr) {
+		player := &guildPlayer{idPlayer: &idPlayer{mockPlayer: newMockPlayer(), id: id}, guildId: guildId}
+		mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+		mgr.InitData(nil)
+		return player, mgr
+	}
+	contribute := func(mgr *PlayerActivityMgr, progress int32) {
+		mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			taskInfo.Progress += progress
+			return true
+		})
+	}
+	a, mgrA := newGuildMgr(21, 1)
+	b, mgrB := newGuildMgr(22, 1)
+	c, mgrC := newGuildMgr(23, 2)
+
+	contribute(mgrA, 6)
+	contribute(mgrC, 5)
+	if err := mgrA.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_TASK_STATE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 达到目标后不再累加
+	contribute(mgrB, 4)
+	contribute(mgrB, 4)
+	task := mgrA.PackOneActivity(conf.GetId()).GetList()[0].GetTemplates()[0].GetGuildTasks()[0]
+	if task.GetProgress() != 10 || task.GetContribution() != 6 || task.GetTaskState() != pb.OperateTaskState_OTS_Finish {
+		t.Fatalf("unexpected guild task %v", task)
+	}
+	if err := mgrA.GetTaskReward(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgrA.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_TASK_STATE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if a.items[1] != 1 {
+		t.Fatalf("unexpected items %v", a.items)
+	}
+
+	// 后加入成员贡献不足
+	c.guildId = 1
+	if err := mgrC.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_GUILD_CONTRIBUTION_NOT_ENOUGH {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 退出公会后不能领奖
+	b.guildId = 0
+	if err := mgrB.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_GUILD_NOT_JOINED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	b.guildId = 1
+	if err := mgrB.GetTaskReward(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommunity(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_COMMUNITY_TYPE,
+		Community: &pb.CommunityTemplate{
+			Task:            &pb.Condition{Condition: 1},
+			MinContribution: 1,
+			Milestones: []*pb.CommunityMilestone{
+				{Target: 10, Reward: []*pb.ItemData{{Id: 1, Num: 1}}},
+				{Target: 100, Reward: []*pb.ItemData{{Id: 1, Num: 2}}},
+			},
+		},
+	})
+	contribute := func(mgr *PlayerActivityMgr, progress int32) {
+		mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			taskInfo.Progress += progress
+			return true
+		})
+	}
+	mgrs := make([]*PlayerActivityMgr, 0, 3)
+	for i := 0; i < 3; i++ {
+		mgr := NewPlayerActivityMgr(&idPlayer{mockPlayer: newMockPlayer(), id: int32(31 + i)}, 101, 10001, nowTimestamp(), nil)
+		mgr.InitData(nil)
+		mgrs = append(mgrs, mgr)
+	}
+	// 其他区服进度独立
+	other := NewPlayerActivityMgr(newMockPlayer(), 102, 10001, nowTimestamp(), nil)
+	other.InitData(nil)
+	contribute(other, 50)
+
+	contribute(mgrs[0], 6)
+	if err := mgrs[0].ClaimMilestone(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_MILESTONE_NOT_REACHED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 直接设置进度时只累加与上次上报的差值
+	for i := 0; i < 2; i++ {
+		mgrs[1].TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			taskInfo.Progress = 6
+			return true
+		})
+	}
+	goal := mgrs[2].PackOneActivity(conf.GetId()).GetList()[0].GetCommunityGoals()[0]
+	if goal.GetProgress() != 12 || !goal.GetMilestones()[0].GetReached() || goal.GetMilestones()[1].GetReached() {
+		t.Fatalf("unexpected goal %v", goal)
+	}
+	// 未贡献玩家不能领取
+	if err := mgrs[2].ClaimMilestone(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_MILESTONE_NOT_ELIGIBLE {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, mgr := range mgrs[:2] {
+		if err := mgr.ClaimMilestone(conf.GetId(), 0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mgrs[0].ClaimMilestone(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_MILESTONE_CLAIMED {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+//
+// passPlayer
+// @Description: 可购买高级通行证的测试玩家
+//
+type passPlayer struct {
+	*mockPlayer
+	products []int32
+}
+
+func (p *passPlayer) OperateBuyPremium(activityId int64, productId int32) error {
+	p.products = append(p.products, productId)
+	return nil
+}
+
+func TestBattlePass(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_BATTLE_PASS_TYPE,
+		BattlePass: &pb.BattlePassTemplate{
+			PremiumProductId: 7,
+			Tasks: []*pb.BattlePassTask{
+				{Task: &pb.Condition{Condition: 1}, Xp: 100},
+				{Task: &pb.Condition{Condition: 2}, Xp: 150},
+			},
+			Levels: []*pb.BattlePassLevel{
+				{Xp: 100, FreeReward: []*pb.ItemData{{Id: 1, Num: 1}}, PremiumReward: []*pb.ItemData{{Id: 2, Num: 1}}},
+				{Xp: 200, FreeReward: []*pb.ItemData{{Id: 1, Num: 2}}, PremiumReward: []*pb.ItemData{{Id: 2, Num: 2}}},
+				{Xp: 500, FreeReward: []*pb.ItemData{{Id: 1, Num: 5}}},
+			},
+		},
+	})
+	finish := func(mgr *PlayerActivityMgr, condition int32) {
+		mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			if conf.GetCondition() != condition {
+				return false
+			}
+			taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+			return true
+		})
+	}
+	freeMgr := NewPlayerActivityMgr(newMockPlayer(), 101, 10001, nowTimestamp(), nil)
+	freeMgr.InitData(nil)
+	if err := freeMgr.BuyPremiumPass(conf.GetId(), 0); ErrorCode(err) != pb.OperateErrorCode_OEC_PASS_PURCHASE_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	player := &passPlayer{mockPlayer: newMockPlayer()}
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	finish(mgr, 1)
+	if _, err := mgr.ClaimPassReward(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgr.ClaimPassReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_PASS_REWARD_CLAIMED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := mgr.ClaimPassReward(conf.GetId(), 0, 1); ErrorCode(err) != pb.OperateErrorCode_OEC_PASS_LEVEL_NOT_REACHED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 任务只获得一次经验
+	finish(mgr, 2)
+	finish(mgr, 2)
+	if level := mgr.PackOneActivity(conf.GetId()).GetList()[0].GetTemplates()[0].GetPassLevel(); level != 2 {
+		t.Fatalf("unexpected level %d", level)
+	}
+
+	// 解锁高级通行证后补领
+	if err := mgr.BuyPremiumPass(conf.GetId(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.BuyPremiumPass(conf.GetId(), 0); ErrorCode(err) != pb.OperateErrorCode_OEC_PASS_PREMIUM_UNLOCKED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := mgr.ClaimPassReward(conf.GetId(), 0, -1); err != nil {
+		t.Fatal(err)
+	}
+	if player.items[1] != 3 || player.items[2] != 3 || len(player.products) != 1 || player.products[0] != 7 {
+		t.Fatalf("unexpected items %v products %v", player.items, player.products)
+	}
+	if _, err := mgr.ClaimPassReward(conf.GetId(), 0, -1); ErrorCode(err) != pb.OperateErrorCode_OEC_PASS_REWARD_CLAIMED {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestRecharge(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_RECHARGE_TYPE,
+		Recharge: &pb.RechargeTemplate{
+			Currency: "CNY",
+			Tiers: []*pb.RechargeTier{
+				{Type: pb.RechargeTierType_RTT_CUMULATIVE, Amount: 100, Reward: []*pb.ItemData{{Id: 1, Num: 1}}},
+				{Type: pb.RechargeTierType_RTT_CUMULATIVE, Amount: 500, Reward: []*pb.ItemData{{Id: 2, Num: 1}}},
+				{Type: pb.RechargeTierType_RTT_SINGLE, Amount: 60, Times: 2, Reward: []*pb.ItemData{{Id: 3, Num: 1}}},
+			},
+		},
+	})
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	if err := mgr.OnRecharge(60, "CNY", "order1"); err != nil {
+		t.Fatal(err)
+	}
+	// 重复订单不计入
+	if err := mgr.OnRecharge(60, "CNY", "order1"); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_ORDER_DUPLICATE {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 货币类型不匹配不计入
+	if err := mgr.OnRecharge(1000, "USD", "order2"); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_NOT_MATCH {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_TIER_NOT_REACHED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.OnRecharge(70, "CNY", "order3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.OnRecharge(80, "CNY", "order4"); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_TIER_CLAIMED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 1); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_TIER_NOT_REACHED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 单笔档位最多领取两次
+	for i := 0; i < 2; i++ {
+		if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 2); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_TIER_CLAIMED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.ClaimRechargeReward(conf.GetId(), 0, 3); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_TIER_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if player.items[1] != 1 || player.items[2] != 0 || player.items[3] != 2 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+	// 订单Id全部保留,存档恢复后仍去重
+	for i := 0; i < 200; i++ {
+		if err := mgr.OnRecharge(1, "CNY", "batch"+strconv.Itoa(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db := map[int64]*pb.OperateActivityDB{conf.GetId(): mgr.getActivity(conf.GetId()).getDbData()}
+	mgr = NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(db)
+	if err := mgr.OnRecharge(60, "CNY", "order1"); ErrorCode(err) != pb.OperateErrorCode_OEC_RECHARGE_ORDER_DUPLICATE {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestChain(t *testing.T) {
+	reward := func(id int32) []*pb.ItemData {
+		return []*pb.ItemData{{Id: id, Num: 1}}
+	}
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CHAIN_TYPE,
+		Chain: &pb.ChainTemplate{
+			Steps: []*pb.ChainStep{
+				{Branches: []*pb.ChainBranch{{Task: &pb.Condition{Condition: 1, RewardList: reward(1)}}}},
+				// 分支:条件2跳到第3步,条件3直接结束
+				{Branches: []*pb.ChainBranch{
+					{Task: &pb.Condition{Condition: 2, RewardList: reward(2)}},
+					{Task: &pb.Condition{Condition: 3, RewardList: reward(3)}, Next: 100},
+				}},
+				{Branches: []*pb.ChainBranch{{Task: &pb.Condition{Condition: 4, RewardList: reward(4)}}}},
+			},
+		},
+	})
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	finish := func(condition int32) {
+		mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+			if conf.GetCondition() != condition {
+				return false
+			}
+			taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+			return true
+		})
+	}
+	chainData := func() *pb.ChainTemplateDB {
+		return mgr.getActivity(conf.GetId()).getChainTemplate(0).getChainData()
+	}
+
+	// 后续步骤的任务不计数
+	finish(2)
+	finish(1)
+	if err := mgr.GetTaskReward(conf.GetId(), 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if chainData().GetStep() != 1 || chainData().GetTaskInfo()[0].GetTaskState() != pb.OperateTaskState_OTS_Doing {
+		t.Fatalf("unexpected chain %v", chainData())
+	}
+	finish(3)
+	if err := mgr.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_TASK_STATE_ERROR {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := mgr.GetTaskReward(conf.GetId(), 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if !chainData().GetFinished() || len(chainData().GetHistory()) != 2 || chainData().GetHistory()[1].GetBranch() != 1 {
+		t.Fatalf("unexpected chain %v", chainData())
+	}
+	if err := mgr.GetTaskReward(conf.GetId(), 0, 0); ErrorCode(err) != pb.OperateErrorCode_OEC_TASK_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if player.items[1] != 1 || player.items[2] != 0 || player.items[3] != 1 || player.items[4] != 0 {
+		t.Fatalf("unexpected items %v", player.items)
+	}
+}
+
+type redeemFailPlayer struct {
+	*failRewardPlayer
+}
+
+func (p *redeemFailPlayer) GetId() int32 {
+	return 4
+}
+
+func TestRedeem(t *testing.T) {
+	conf := newTestActivity(t)
+	path := filepath.Join(t.TempDir(), "redeem.json")
+	store, err := NewFileRedeemStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetRedeemCodeStore(store)
+	t.Cleanup(func() {
+		SetRedeemCodeStore(NewMemoryRedeemStore())
+	})
+	newMgr := func(player IPlayer) *PlayerActivityMgr {
+		mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+		mgr.InitData(nil)
+		return mgr
+	}
+	mgrA := newMgr(newMockPlayer())
+	mgrB := newMgr(&idPlayer{mockPlayer: newMockPlayer(), id: 3})
+
+	// 通用码每个玩家兑换一次
+	if err := AddUniversalRedeemCode(&RedeemBatch{BatchId: "universal", Reward: []*pb.ItemData{{Id: 1, Num: 1}}}, "Welcome"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem(" welcome "); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem("WELCOME"); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_PLAYER_LIMIT {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := mgrB.Redeem("WELCOME"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 绑定活动的生成码每个码只能使用一次
+	codes, err := GenerateRedeemCodes(&RedeemBatch{BatchId: "batch", ActivityId: conf.GetId(), Reward: []*pb.ItemData{{Id: 2, Num: 1}}}, 2, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem(codes[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem(codes[1]); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_PLAYER_LIMIT {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := mgrB.Redeem(codes[0]); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_CODE_USED_UP {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 发放奖励失败时不消耗兑换码
+	failMgr := newMgr(&redeemFailPlayer{failRewardPlayer: &failRewardPlayer{mockPlayer: newMockPlayer()}})
+	if _, err := failMgr.Redeem(codes[1]); ErrorCode(err) != pb.OperateErrorCode_OEC_ADD_REWARD_FAIL {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := mgrB.Redeem(codes[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := mgrA.Redeem("NOTEXIST"); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_CODE_NOT_EXIST {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := AddUniversalRedeemCode(&RedeemBatch{BatchId: "expired", EndTime: nowTimestamp() - 1, Reward: []*pb.ItemData{{Id: 1, Num: 1}}}, "EXPIRED"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem("EXPIRED"); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_CODE_EXPIRED {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := AddUniversalRedeemCode(&RedeemBatch{BatchId: "channel", Channels: []int32{1}, Reward: []*pb.ItemData{{Id: 1, Num: 1}}}, "CHANNEL"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mgrA.Redeem("CHANNEL"); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_RESTRICTED {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// 重新加载文件后使用次数保留
+	reload, err := NewFileRedeemStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetRedeemCodeStore(reload)
+	if _, err := mgrA.Redeem("WELCOME"); ErrorCode(err) != pb.OperateErrorCode_OEC_REDEEM_PLAYER_LIMIT {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestMail(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONDITION_TYPE,
+		Condition: &pb.ConditionTemplate{Data: []*pb.Condition{
+			{Condition: 1, RefreshType: pb.TaskRefreshType_TRT_DAY, RewardList: []*pb.ItemData{{Id: 1, Num: 1}}},
+			{Condition: 1, RewardList: []*pb.ItemData{{Id: 1, Num: 2}}},
+			{Condition: 1, RewardList: []*pb.ItemData{{Id: 1, Num: 3}, {Id: 2, Num: 1}}},
+		}},
+	})
+	conf.ActivityName = "mail"
+	conf.Mails = []*pb.OperateMailConf{
+		{Reason: pb.OperateMailReason_OMR_TASK_RESET, TitleKey: "reset_title", BodyKey: "reset_body"},
+		{Reason: pb.OperateMailReason_OMR_ACTIVITY_END, TitleKey: "end_title", BodyKey: "end_body"},
+	}
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+		taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+		return true
+	})
+
+	// 任务重置只补发完成未领取的重置任务奖励
+	mgr.OnNewDay()
+	if len(player.mails) != 1 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+	mail := player.mails[0]
+	if mail.GetReason() != pb.OperateMailReason_OMR_TASK_RESET || mail.GetResetType() != pb.TaskRefreshType_TRT_DAY ||
+		mail.GetTitleKey() != "reset_title" || len(mail.GetItems()) != 1 || mail.GetItems()[0].GetNum() != 1 {
+		t.Fatalf("unexpected reset mail %v", mail)
+	}
+
+	// 活动删除时所有未领取奖励合并为一封邮件
+	mgr.Delete(conf.GetId())
+	if len(player.mails) != 2 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+	mail = player.mails[1]
+	if mail.GetReason() != pb.OperateMailReason_OMR_ACTIVITY_END || mail.GetActivityName() != "mail" || mail.GetBodyKey() != "end_body" {
+		t.Fatalf("unexpected end mail %v", mail)
+	}
+	if len(mail.GetSources()) != 2 || mail.GetSources()[0].GetType() != pb.OperateMailSourceType_OMST_TASK || mail.GetSources()[1].GetIndex() != 2 {
+		t.Fatalf("unexpected sources %v", mail.GetSources())
+	}
+	if len(mail.GetItems()) != 2 || mail.GetItems()[0].GetNum() != 5 || mail.GetItems()[1].GetNum() != 1 {
+		t.Fatalf("unexpected items %v", mail.GetItems())
+	}
+}
+
+func TestResetTaskMail(t *testing.T) {
+	conf := newTestActivity(t, &pb.ActivityTemplate{
+		TemplateType: pb.ActivityTemplateType_CONDITION_TYPE,
+		Condition: &pb.ConditionTemplate{Data: []*pb.Condition{
+			{Condition: 1, RefreshType: pb.TaskRefreshType_TRT_DAY, RewardList: []*pb.ItemData{{Id: 1, Num: 1}}},
+			{Condition: 2, RefreshType: pb.TaskRefreshType_TRT_DAY, RewardList: []*pb.ItemData{{Id: 1, Num: 2}}},
+			{Condition: 3, RefreshType: pb.TaskRefreshType_TRT_DAY, RewardList: []*pb.ItemData{{Id: 1, Num: 4}}},
+		}},
+	})
+	player := newMockPlayer()
+	mgr := NewPlayerActivityMgr(player, 101, 10001, nowTimestamp(), nil)
+	mgr.InitData(nil)
+	// 任务1完成未领取,任务2进行中,任务3已领取
+	mgr.TriggerCondition(func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+		if conf.GetCondition() == 2 {
+			taskInfo.Progress = 1
+		} else {
+			taskInfo.TaskState = pb.OperateTaskState_OTS_Finish
+		}
+		return true
+	})
+	if err := mgr.GetTaskReward(conf.GetId(), 0, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// 重置时只补发完成未领取的任务奖励
+	mgr.OnNewDay()
+	if len(player.mails) != 1 || len(player.mails[0].GetItems()) != 1 || player.mails[0].GetItems()[0].GetNum() != 1 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+	// 重置后任务回到进行中,不再重复补发
+	mgr.OnNewDay()
+	if len(player.mails) != 1 {
+		t.Fatalf("unexpected mails %v", player.mails)
+	}
+}
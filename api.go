@@ -10,6 +10,7 @@ package activity
 
 import (
 	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
 )
 
 //
@@ -61,6 +62,152 @@ func GetAreaRegisterTime(areaId int32) int64 {
 	return areaRegisterTimeCb(areaId)
 }
 
+//
+// SetRewardPoolFun
+// @Description: 设置获取奖池回调,抽奖模板根据RewardPoolId获取奖池
+// @param cb
+//
+func SetRewardPoolFun(cb RewardPoolFun) {
+	rewardPoolCb = cb
+}
+
+//
+// RegisterTemplate
+// @Description: 注册自定义活动模板,需在创建玩家活动数据前调用。自定义类型建议使用1000以上的值,避免与内置类型冲突
+// @param tt 模板类型
+// @param f 模板构造函数
+//
+func RegisterTemplate(tt pb.ActivityTemplateType, f NewTemplateFunc) {
+	if f == nil {
+		logError("RegisterTemplate func is nil", zap.Any("type", tt))
+		return
+	}
+	registerTemplate(tt, newCustomTemplateFunc(f))
+}
+
+//
+// SetCounterBackend
+// @Description: 设置全服计数存储(商品全服库存等),默认进程内MemoryCounter,多进程共享库存时替换为共享存储
+// @param backend
+//
+func SetCounterBackend(backend CounterBackend) {
+	if backend == nil {
+		return
+	}
+	getGlobalOperateActivityMgr().counter = backend
+}
+
+//
+// SetLeaderboardBackend
+// @Description: 设置排行榜存储,默认进程内MemoryLeaderboard,多进程共享排行时替换为共享存储
+// @param backend
+//
+func SetLeaderboardBackend(backend LeaderboardBackend) {
+	if backend == nil {
+		return
+	}
+	getGlobalOperateActivityMgr().leaderboard = backend
+}
+
+//
+// SetMaxRankPageSize
+// @Description: 设置排行榜每页最大数量,客户端请求超过时按最大数量返回,默认100
+// @param size
+//
+func SetMaxRankPageSize(size int32) {
+	if size <= 0 {
+		return
+	}
+	maxRankPageSize = size
+}
+
+//
+// SetRedeemCodeStore
+// @Description: 设置兑换码存储,默认进程内MemoryRedeemStore,需要持久化时可使用FileRedeemStore或替换为共享存储
+// @param store
+//
+func SetRedeemCodeStore(store RedeemCodeStore) {
+	if store == nil {
+		return
+	}
+	getGlobalOperateActivityMgr().redeemStore = store
+}
+
+//
+// GenerateRedeemCodes
+// @Description: 生成一批兑换码,每个码默认只能使用一次
+// @param batch 批次配置
+// @param count 生成数量
+// @param length 兑换码长度,不小于6
+// @return []string 生成的兑换码
+// @return error
+//
+func GenerateRedeemCodes(batch *RedeemBatch, count int, length int) ([]string, error) {
+	if batch == nil || batch.BatchId == "" || len(batch.Reward) <= 0 {
+		return nil, paramError.with("batch", batch)
+	}
+	codes, err := generateRedeemCodes(count, length)
+	if err != nil {
+		return nil, paramError.wrap(err)
+	}
+	batch.Universal = false
+	if err = getGlobalOperateActivityMgr().redeemStore.AddBatch(batch, codes); err != nil {
+		return nil, dbError.with("batchId", batch.BatchId).wrap(err)
+	}
+	logInfo("生成兑换码", zap.String("batchId", batch.BatchId), zap.Int("count", count))
+	return codes, nil
+}
+
+//
+// AddUniversalRedeemCode
+// @Description: 添加通用兑换码,所有玩家共用一个码
+// @param batch 批次配置
+// @param code 兑换码
+// @return error
+//
+func AddUniversalRedeemCode(batch *RedeemBatch, code string) error {
+	code = normalizeRedeemCode(code)
+	if batch == nil || batch.BatchId == "" || len(batch.Reward) <= 0 || code == "" {
+		return paramError.with("batch", batch).with("code", code)
+	}
+	batch.Universal = true
+	if err := getGlobalOperateActivityMgr().redeemStore.AddBatch(batch, []string{code}); err != nil {
+		return dbError.with("batchId", batch.BatchId).wrap(err)
+	}
+	logInfo("添加通用兑换码", zap.String("batchId", batch.BatchId), zap.String("code", code))
+	return nil
+}
+
+//
+// SetLedgerSink
+// @Description: 设置奖励流水存储,所有奖励发放/消耗扣除/邮件发送均会生成流水
+// @param sink
+//
+func SetLedgerSink(sink LedgerSink) {
+	setLedgerSink(sink)
+}
+
+//
+// SetMetricsRegistry
+// @Description: 设置指标注册器,默认使用进程内Registry
+// @param registry
+//
+func SetMetricsRegistry(registry MetricsRegistry) {
+	if registry == nil {
+		return
+	}
+	metricsRegistry = registry
+}
+
+//
+// GetMetricsRegistry
+// @Description: 获取指标注册器,默认Registry可通过WritePrometheus导出
+// @return MetricsRegistry
+//
+func GetMetricsRegistry() MetricsRegistry {
+	return getMetricsRegistry()
+}
+
 //
 // Init
 // @Description: 初始化全局管理器
@@ -0,0 +1,140 @@
+/**
+ * @Author: dingqinghui
+ * @Description:全服计数器
+ * @File:  counter
+ * @Version: 1.0.0
+ * @Date: 2022/8/19 10:20
+ */
+
+package activity
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//
+// CounterBackend
+// @Description: 全服计数存储,所有操作需保证原子性,多进程部署时可替换为redis等共享存储
+//
+type CounterBackend interface {
+	// Get 获取计数,不存在返回0
+	Get(key string) (int64, error)
+	// Add 增加计数,delta可为负数,返回增加后的值
+	Add(key string, delta int64) (int64, error)
+	// AddLimit 增加计数,增加后超过limit时不修改并返回false
+	AddLimit(key string, delta int64, limit int64) (int64, bool, error)
+	// DeletePrefix 删除所有指定前缀的计数
+	DeletePrefix(prefix string) error
+}
+
+//
+// activityCounterPrefix
+// @Description: 活动计数key前缀,活动删除时清理
+// @param activityId
+// @return string
+//
+func activityCounterPrefix(activityId int64) string {
+	return fmt.Sprintf("activity:%d:", activityId)
+}
+
+//
+// stockCounterKey
+// @Description: 商品全服库存key
+// @param activityId
+// @param areaId
+// @param day
+// @param tplIndex
+// @param goodsIndex
+// @return string
+//
+func stockCounterKey(activityId int64, areaId int32, day int32, tplIndex int32, goodsIndex int) string {
+	return fmt.Sprintf("%sstock:%d:%d:%d:%d", activityCounterPrefix(activityId), areaId, day, tplIndex, goodsIndex)
+}
+
+//
+// communityCounterKey
+// @Description: 全服目标进度key
+// @param activityId
+// @param areaId
+// @param day
+// @param tplIndex
+// @return string
+//
+func communityCounterKey(activityId int64, areaId int32, day int32, tplIndex int32) string {
+	return fmt.Sprintf("%scommunity:%d:%d:%d", activityCounterPrefix(activityId), areaId, day, tplIndex)
+}
+
+//
+// guildTaskCounterKey
+// @Description: 公会任务总进度key
+// @param activityId
+// @param guildId
+// @param day
+// @param tplIndex
+// @param taskIndex
+// @return string
+//
+func guildTaskCounterKey(activityId int64, guildId int64, day int32, tplIndex int32, taskIndex int) string {
+	return fmt.Sprintf("%sguild:%d:%d:%d:%d", activityCounterPrefix(activityId), guildId, day, tplIndex, taskIndex)
+}
+
+//
+// guildMemberCounterKey
+// @Description: 公会任务成员贡献key
+// @param taskKey 公会任务总进度key
+// @param playerId
+// @return string
+//
+func guildMemberCounterKey(taskKey string, playerId int32) string {
+	return fmt.Sprintf("%s:member:%d", taskKey, playerId)
+}
+
+//
+// MemoryCounter
+// @Description: 进程内计数存储
+//
+type MemoryCounter struct {
+	sync.Mutex
+	values map[string]int64
+}
+
+func NewMemoryCounter() *MemoryCounter {
+	return &MemoryCounter{values: make(map[string]int64)}
+}
+
+func (m *MemoryCounter) Get(key string) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.values[key], nil
+}
+
+func (m *MemoryCounter) Add(key string, delta int64) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.values[key] += delta
+	return m.values[key], nil
+}
+
+func (m *MemoryCounter) AddLimit(key string, delta int64, limit int64) (int64, bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	value := m.values[key] + delta
+	if value > limit {
+		return m.values[key], false, nil
+	}
+	m.values[key] = value
+	return value, true, nil
+}
+
+func (m *MemoryCounter) DeletePrefix(prefix string) error {
+	m.Lock()
+	defer m.Unlock()
+	for key := range m.values {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.values, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+/**
+ * @Author: dingqinghui
+ * @Description:错误定义
+ * @File:  errors
+ * @Version: 1.0.0
+ * @Date: 2022/8/11 10:15
+ */
+
+package activity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dingqinghui/activity/pb"
+	"sort"
+	"strings"
+)
+
+// 错误定义
+var (
+	// activityNotExist 活动不存在
+	activityNotExist = newOperateError(pb.OperateErrorCode_OEC_ACTIVITY_NOT_EXIST, "activity not exist")
+	// activityNotOpen 活动未到开启时间
+	activityNotOpen = newOperateError(pb.OperateErrorCode_OEC_ACTIVITY_NOT_OPEN, "activity not open")
+	// preConditionNotFinish 活动前置条件未满足
+	preConditionNotFinish = newOperateError(pb.OperateErrorCode_OEC_PRE_CONDITION_NOT_FINISH, "pre condition not finish")
+	// templateNotExist 模板不存在
+	templateNotExist = newOperateError(pb.OperateErrorCode_OEC_TEMPLATE_NOT_EXIST, "template not exist")
+	// paramError 参数错误
+	paramError = newOperateError(pb.OperateErrorCode_OEC_PARAM_ERROR, "param error")
+	// confError 配置错误
+	confError = newOperateError(pb.OperateErrorCode_OEC_CONF_ERROR, "conf error")
+	// dbError 存档数据错误
+	dbError = newOperateError(pb.OperateErrorCode_OEC_DB_ERROR, "db data error")
+	// unknownMsg 未知协议
+	unknownMsg = newOperateError(pb.OperateErrorCode_OEC_UNKNOWN_MSG, "unknown msg")
+	// costNotEnough 消耗不足
+	costNotEnough = newOperateError(pb.OperateErrorCode_OEC_COST_NOT_ENOUGH, "cost not enough")
+	// subCostFail 扣除消耗失败
+	subCostFail = newOperateError(pb.OperateErrorCode_OEC_SUB_COST_FAIL, "sub cost fail")
+	// addRewardFail 发放奖励失败
+	addRewardFail = newOperateError(pb.OperateErrorCode_OEC_ADD_REWARD_FAIL, "add reward fail")
+	// dependencyNotFinish 依赖活动未完成
+	dependencyNotFinish = newOperateError(pb.OperateErrorCode_OEC_DEPENDENCY_NOT_FINISH, "dependency activity not finish")
+
+	// signTriggerError 签到触发类型错误
+	signTriggerError = newOperateError(pb.OperateErrorCode_OEC_SIGN_TRIGGER_ERROR, "sign trigger error")
+	// signTodaySigned 今日已签到
+	signTodaySigned = newOperateError(pb.OperateErrorCode_OEC_SIGN_TODAY_SIGNED, "today signed")
+	// signCountLimit 签到次数已达上限
+	signCountLimit = newOperateError(pb.OperateErrorCode_OEC_SIGN_COUNT_LIMIT, "sign count limit")
+	// signNotSigned 未签到
+	signNotSigned = newOperateError(pb.OperateErrorCode_OEC_SIGN_NOT_SIGNED, "not signed")
+	// signRewardGot 签到奖励已领取
+	signRewardGot = newOperateError(pb.OperateErrorCode_OEC_SIGN_REWARD_GOT, "sign reward got")
+	// repairCountLimit 补签次数已达上限
+	repairCountLimit = newOperateError(pb.OperateErrorCode_OEC_REPAIR_COUNT_LIMIT, "repair sign count limit")
+	// repairDayCountLimit 每日补签次数已达上限
+	repairDayCountLimit = newOperateError(pb.OperateErrorCode_OEC_REPAIR_DAY_COUNT_LIMIT, "every day repair sign count limit")
+	// repairTaskNotFinish 补签任务未完成
+	repairTaskNotFinish = newOperateError(pb.OperateErrorCode_OEC_REPAIR_TASK_NOT_FINISH, "repair condition task not finish")
+	// signModeError 签到模式不支持该操作
+	signModeError = newOperateError(pb.OperateErrorCode_OEC_SIGN_MODE_ERROR, "sign mode not support")
+	// signDateError 补签日期错误
+	signDateError = newOperateError(pb.OperateErrorCode_OEC_SIGN_DATE_ERROR, "sign date error")
+	// signDateSigned 该日期已签到
+	signDateSigned = newOperateError(pb.OperateErrorCode_OEC_SIGN_DATE_SIGNED, "date signed")
+
+	// goodsNotExist 商品不存在
+	goodsNotExist = newOperateError(pb.OperateErrorCode_OEC_SHOP_GOODS_NOT_EXIST, "shop conf goods not exist")
+	// goodsLimit 商品已达限购次数
+	goodsLimit = newOperateError(pb.OperateErrorCode_OEC_SHOP_GOODS_LIMIT, "goods limit")
+	// stockNotEnough 全服库存不足
+	stockNotEnough = newOperateError(pb.OperateErrorCode_OEC_SHOP_STOCK_NOT_ENOUGH, "goods stock not enough")
+
+	// taskNotExist 任务不存在
+	taskNotExist = newOperateError(pb.OperateErrorCode_OEC_TASK_NOT_EXIST, "task not exist")
+	// taskStateError 任务状态错误
+	taskStateError = newOperateError(pb.OperateErrorCode_OEC_TASK_STATE_ERROR, "task status err")
+
+	// scoreNotExist 积分奖励不存在
+	scoreNotExist = newOperateError(pb.OperateErrorCode_OEC_SCORE_NOT_EXIST, "scoreSystem index out")
+	// scoreRewardGot 积分奖励已领取
+	scoreRewardGot = newOperateError(pb.OperateErrorCode_OEC_SCORE_REWARD_GOT, "scoreSystem index got")
+
+	// lotteryNotExist 抽奖不存在
+	lotteryNotExist = newOperateError(pb.OperateErrorCode_OEC_LOTTERY_NOT_EXIST, "lottery not exist")
+	// lotteryPoolEmpty 奖池为空
+	lotteryPoolEmpty = newOperateError(pb.OperateErrorCode_OEC_LOTTERY_POOL_EMPTY, "lottery reward pool empty")
+
+	// guildNotJoined 未加入公会
+	guildNotJoined = newOperateError(pb.OperateErrorCode_OEC_GUILD_NOT_JOINED, "guild not joined")
+	// guildContributionNotEnough 公会贡献不足
+	guildContributionNotEnough = newOperateError(pb.OperateErrorCode_OEC_GUILD_CONTRIBUTION_NOT_ENOUGH, "guild contribution not enough")
+
+	// milestoneNotExist 全服目标档位不存在
+	milestoneNotExist = newOperateError(pb.OperateErrorCode_OEC_MILESTONE_NOT_EXIST, "milestone not exist")
+	// milestoneNotReached 全服目标档位未达成
+	milestoneNotReached = newOperateError(pb.OperateErrorCode_OEC_MILESTONE_NOT_REACHED, "milestone not reached")
+	// milestoneClaimed 全服目标档位奖励已领取
+	milestoneClaimed = newOperateError(pb.OperateErrorCode_OEC_MILESTONE_CLAIMED, "milestone claimed")
+	// milestoneNotEligible 个人贡献不足,不能领取全服目标奖励
+	milestoneNotEligible = newOperateError(pb.OperateErrorCode_OEC_MILESTONE_NOT_ELIGIBLE, "milestone not eligible")
+
+	// passLevelNotExist 通行证等级不存在
+	passLevelNotExist = newOperateError(pb.OperateErrorCode_OEC_PASS_LEVEL_NOT_EXIST, "battle pass level not exist")
+	// passLevelNotReached 通行证等级未达到
+	passLevelNotReached = newOperateError(pb.OperateErrorCode_OEC_PASS_LEVEL_NOT_REACHED, "battle pass level not reached")
+	// passRewardClaimed 通行证等级奖励已领取
+	passRewardClaimed = newOperateError(pb.OperateErrorCode_OEC_PASS_REWARD_CLAIMED, "battle pass reward claimed")
+	// passPremiumUnlocked 高级通行证已解锁
+	passPremiumUnlocked = newOperateError(pb.OperateErrorCode_OEC_PASS_PREMIUM_UNLOCKED, "battle pass premium unlocked")
+	// passPurchaseFail 高级通行证购买失败
+	passPurchaseFail = newOperateError(pb.OperateErrorCode_OEC_PASS_PURCHASE_FAIL, "battle pass purchase fail")
+
+	// rechargeTierNotExist 充值档位不存在
+	rechargeTierNotExist = newOperateError(pb.OperateErrorCode_OEC_RECHARGE_TIER_NOT_EXIST, "recharge tier not exist")
+	// rechargeTierNotReached 充值档位未达成
+	rechargeTierNotReached = newOperateError(pb.OperateErrorCode_OEC_RECHARGE_TIER_NOT_REACHED, "recharge tier not reached")
+	// rechargeTierClaimed 充值档位奖励已领取
+	rechargeTierClaimed = newOperateError(pb.OperateErrorCode_OEC_RECHARGE_TIER_CLAIMED, "recharge tier claimed")
+	// rechargeOrderDuplicate 充值订单重复
+	rechargeOrderDuplicate = newOperateError(pb.OperateErrorCode_OEC_RECHARGE_ORDER_DUPLICATE, "recharge order duplicate")
+	// rechargeNotMatch 没有匹配的充值模板
+	rechargeNotMatch = newOperateError(pb.OperateErrorCode_OEC_RECHARGE_NOT_MATCH, "recharge template not match")
+
+	// redeemCodeNotExist 兑换码不存在
+	redeemCodeNotExist = newOperateError(pb.OperateErrorCode_OEC_REDEEM_CODE_NOT_EXIST, "redeem code not exist")
+	// redeemCodeExpired 兑换码不在有效期内
+	redeemCodeExpired = newOperateError(pb.OperateErrorCode_OEC_REDEEM_CODE_EXPIRED, "redeem code expired")
+	// redeemCodeUsedUp 兑换码使用次数已用完
+	redeemCodeUsedUp = newOperateError(pb.OperateErrorCode_OEC_REDEEM_CODE_USED_UP, "redeem code used up")
+	// redeemPlayerLimit 玩家兑换次数已达上限
+	redeemPlayerLimit = newOperateError(pb.OperateErrorCode_OEC_REDEEM_PLAYER_LIMIT, "redeem player limit")
+	// redeemRestricted 兑换码不能在当前渠道/区服使用
+	redeemRestricted = newOperateError(pb.OperateErrorCode_OEC_REDEEM_RESTRICTED, "redeem restricted")
+)
+
+//
+// OperateError
+// @Description: 运营活动错误,携带错误码和上下文信息
+//
+type OperateError struct {
+	//
+	// Code
+	// @Description: 错误码
+	//
+	Code pb.OperateErrorCode
+	//
+	// Msg
+	// @Description: 错误描述
+	//
+	Msg string
+	//
+	// Context
+	// @Description: 上下文信息
+	//
+	Context map[string]interface{}
+	//
+	// Cause
+	// @Description: 原始错误(如IPlayer接口返回的错误)
+	//
+	Cause error
+}
+
+func newOperateError(code pb.OperateErrorCode, msg string) *OperateError {
+	return &OperateError{Code: code, Msg: msg}
+}
+
+//
+// with
+// @Description: 拷贝错误并添加上下文
+// @receiver e
+// @param key
+// @param value
+// @return *OperateError
+//
+func (e *OperateError) with(key string, value interface{}) *OperateError {
+	c := e.clone()
+	c.Context[key] = value
+	return c
+}
+
+//
+// wrap
+// @Description: 拷贝错误并设置原始错误
+// @receiver e
+// @param cause
+// @return *OperateError
+//
+func (e *OperateError) wrap(cause error) *OperateError {
+	c := e.clone()
+	c.Cause = cause
+	return c
+}
+
+func (e *OperateError) clone() *OperateError {
+	c := &OperateError{
+		Code:    e.Code,
+		Msg:     e.Msg,
+		Context: make(map[string]interface{}, len(e.Context)+1),
+		Cause:   e.Cause,
+	}
+	for k, v := range e.Context {
+		c.Context[k] = v
+	}
+	return c
+}
+
+func (e *OperateError) Error() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s(%s)", e.Msg, e.Code.String()))
+	keys := make([]string, 0, len(e.Context))
+	for k := range e.Context {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf(" %s=%v", k, e.Context[k]))
+	}
+	if e.Cause != nil {
+		b.WriteString(": ")
+		b.WriteString(e.Cause.Error())
+	}
+	return b.String()
+}
+
+func (e *OperateError) Unwrap() error {
+	return e.Cause
+}
+
+//
+// Is
+// @Description: 错误码相同即认为是同一错误,支持errors.Is
+// @receiver e
+// @param target
+// @return bool
+//
+func (e *OperateError) Is(target error) bool {
+	t, ok := target.(*OperateError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+//
+// ErrorCode
+// @Description: 获取错误码
+// @param err
+// @return pb.OperateErrorCode
+//
+func ErrorCode(err error) pb.OperateErrorCode {
+	if err == nil {
+		return pb.OperateErrorCode_OEC_SUCCESS
+	}
+	var e *OperateError
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return pb.OperateErrorCode_OEC_UNKNOWN
+}
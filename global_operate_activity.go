@@ -18,6 +18,8 @@ import (
 type (
 	// AreaRegisterTimeFun 获取区服注册时间
 	AreaRegisterTimeFun func(int32) int64
+	// RewardPoolFun 根据奖池Id获取奖池
+	RewardPoolFun func(poolId int32) []*pb.RewardPool
 )
 
 var (
@@ -27,6 +29,10 @@ var (
 	timeZero = 8
 	// 获取区服注册时间回调
 	areaRegisterTimeCb AreaRegisterTimeFun
+	// 获取奖池回调
+	rewardPoolCb RewardPoolFun
+	// maxRankPageSize 排行榜每页最大数量
+	maxRankPageSize int32 = 100
 )
 
 // 活动全局管理模块
@@ -42,7 +48,11 @@ var (
 //
 func getGlobalOperateActivityMgr() *operatorActivityMgr {
 	onceActivityMgr.Do(func() {
-		globalOperateActivityMgr = new(operatorActivityMgr)
+		globalOperateActivityMgr = &operatorActivityMgr{
+			counter:     NewMemoryCounter(),
+			leaderboard: NewMemoryLeaderboard(),
+			redeemStore: NewMemoryRedeemStore(),
+		}
 	})
 	return globalOperateActivityMgr
 }
@@ -63,6 +73,30 @@ type operatorActivityMgr struct {
 	// @Description: 状态变化回调函数
 	//
 	changStatusCallback DataCmdFun
+
+	//
+	// counter
+	// @Description: 全服计数存储(商品库存等)
+	//
+	counter CounterBackend
+
+	//
+	// leaderboard
+	// @Description: 排行榜存储
+	//
+	leaderboard LeaderboardBackend
+
+	//
+	// closingActivities
+	// @Description: 已结束待清理的活动 key:活动Id value:活动配置,关闭前保留配置和排行榜用于离线玩家结算
+	//
+	closingActivities sync.Map
+
+	//
+	// redeemStore
+	// @Description: 兑换码存储
+	//
+	redeemStore RedeemCodeStore
 }
 
 func (m *operatorActivityMgr) init(initData []*pb.OperateActivity, cb DataCmdFun) {
@@ -91,18 +125,159 @@ func (m *operatorActivityMgr) batchDelete(deleteList []*pb.OperateActivity) erro
 		return nil
 	}
 	for _, activity := range deleteList {
-		m.activityMap.Delete(activity.GetId())
+		if _, ok := m.activityMap.LoadAndDelete(activity.GetId()); ok {
+			addGauge(metricGlobalActivities, "全局活动数量", -1)
+		}
 		m.callDataCmdFun(activity, DataDelete)
+		m.clearCounter(activity.GetId())
+		m.closingActivities.Store(activity.GetId(), activity)
+		incCounter(metricGlobalExpireTotal, "全局活动过期次数")
 		logInfo("db删除过期运营活动数据", zap.Int64("activityId", activity.GetId()))
 	}
 	return nil
 }
 
 func (m *operatorActivityMgr) delete(activityId int64) {
-	m.activityMap.Delete(activityId)
+	value, ok := m.activityMap.LoadAndDelete(activityId)
+	if ok {
+		addGauge(metricGlobalActivities, "全局活动数量", -1)
+	}
+	m.clearCounter(activityId)
+	// 绝对时间活动排行榜保留到关闭时间,未结算的玩家删除活动时仍可结算
+	if activity, _ := value.(*pb.OperateActivity); activity.GetTimeType() == pb.OperateActivityTimeType_ABSOLUTE_TIME {
+		m.closingActivities.Store(activityId, activity)
+		m.clearClosedLeaderboard()
+	} else if _, closing := m.closingActivities.Load(activityId); !closing {
+		m.clearLeaderboard(activityId)
+	}
+	incCounter(metricGlobalDeleteTotal, "全局活动删除次数")
 	logInfo("删除运营活动数据", zap.Int64("activityId", activityId))
 }
 
+//
+// clearCounter
+// @Description: 清理活动全服计数
+// @receiver m
+// @param activityId
+//
+func (m *operatorActivityMgr) clearCounter(activityId int64) {
+	if err := m.counter.DeletePrefix(activityCounterPrefix(activityId)); err != nil {
+		logError("清理活动全服计数失败", zap.Int64("activityId", activityId), zap.Error(err))
+	}
+}
+
+//
+// clearLeaderboard
+// @Description: 清理活动排行榜
+// @receiver m
+// @param activityId
+//
+func (m *operatorActivityMgr) clearLeaderboard(activityId int64) {
+	m.closingActivities.Delete(activityId)
+	if err := m.leaderboard.DeletePrefix(activityCounterPrefix(activityId)); err != nil {
+		logError("清理活动排行榜失败", zap.Int64("activityId", activityId), zap.Error(err))
+	}
+}
+
+//
+// clearClosedLeaderboard
+// @Description: 清理已到关闭时间的排行榜
+// @receiver m
+//
+func (m *operatorActivityMgr) clearClosedLeaderboard() {
+	now := nowTimestamp()
+	m.closingActivities.Range(func(key, value interface{}) bool {
+		if activity, _ := value.(*pb.OperateActivity); now >= activity.GetCloseDuration() {
+			m.clearLeaderboard(key.(int64))
+		}
+		return true
+	})
+}
+
+//
+// takeStock
+// @Description: 扣除一个全服库存
+// @receiver m
+// @param key
+// @param stock 总库存
+// @return error
+//
+func (m *operatorActivityMgr) takeStock(key string, stock int32) error {
+	_, ok, err := m.counter.AddLimit(key, 1, int64(stock))
+	if err != nil {
+		return dbError.with("key", key).wrap(err)
+	}
+	if !ok {
+		return stockNotEnough.with("key", key).with("stock", stock)
+	}
+	return nil
+}
+
+//
+// returnStock
+// @Description: 归还一个全服库存
+// @receiver m
+// @param key
+//
+func (m *operatorActivityMgr) returnStock(key string) {
+	if _, err := m.counter.Add(key, -1); err != nil {
+		logError("归还全服库存失败", zap.String("key", key), zap.Error(err))
+	}
+}
+
+//
+// remainStock
+// @Description: 获取剩余全服库存
+// @receiver m
+// @param key
+// @param stock 总库存
+// @return int32
+//
+func (m *operatorActivityMgr) remainStock(key string, stock int32) int32 {
+	sold, err := m.counter.Get(key)
+	if err != nil {
+		logError("获取全服库存失败", zap.String("key", key), zap.Error(err))
+		return 0
+	}
+	if remain := int64(stock) - sold; remain > 0 {
+		return int32(remain)
+	}
+	return 0
+}
+
+//
+// addCommunityProgress
+// @Description: 增加全服目标进度
+// @receiver m
+// @param key
+// @param delta
+// @return int64 增加后的进度
+// @return error
+//
+func (m *operatorActivityMgr) addCommunityProgress(key string, delta int64) (int64, error) {
+	progress, err := m.counter.Add(key, delta)
+	if err != nil {
+		return 0, dbError.with("key", key).wrap(err)
+	}
+	return progress, nil
+}
+
+//
+// getCommunityProgress
+// @Description: 获取全服目标进度
+// @receiver m
+// @param key
+// @return int64
+//
+func (m *operatorActivityMgr) getCommunityProgress(key string) int64 {
+	progress, err := m.counter.Get(key)
+	if err != nil {
+		logError("获取全服目标进度失败", zap.String("key", key), zap.Error(err))
+		return 0
+	}
+	return progress
+}
+
 func (m *operatorActivityMgr) callDataCmdFun(activity *pb.OperateActivity, cmd DataCmd) {
 	if m.changStatusCallback == nil {
 		return
@@ -125,6 +300,8 @@ func (m *operatorActivityMgr) addCache(pActivity *pb.OperateActivity) bool {
 	}
 	m.callDataCmdFun(pActivity, DataAdd)
 	m.activityMap.Store(pActivity.GetId(), pActivity)
+	incCounter(metricGlobalAddTotal, "全局活动添加次数")
+	addGauge(metricGlobalActivities, "全局活动数量", 1)
 
 	logInfo("添加运营活动实例成功", zap.Int64("activityId", pActivity.GetId()), zap.Any("activity", pActivity))
 	return true
@@ -155,6 +332,7 @@ func (m *operatorActivityMgr) rangeAll(f func(*pb.OperateActivity)) {
 		return true
 	})
 	_ = m.batchDelete(deleteList)
+	m.clearClosedLeaderboard()
 }
 
 //
@@ -174,12 +352,29 @@ func (m *operatorActivityMgr) getActivity(activityId int64) *pb.OperateActivity
 		logError("invalid activity data type", zap.String("dataType", reflect.TypeOf(activity).String()))
 		return nil
 	}
-	deleteList := make([]*pb.OperateActivity, 0, 0)
 	if m.checkExpire(activity) {
-		deleteList = append(deleteList, activity)
+		_ = m.batchDelete([]*pb.OperateActivity{activity})
+		return nil
+	}
+	return activity
+}
+
+//
+// getClosingActivity
+// @Description: 获取已结束未关闭的活动配置,用于结束时离线的玩家登录后结算
+// @receiver m
+// @param activityId
+// @return *pb.OperateActivity 不存在或已关闭返回nil
+//
+func (m *operatorActivityMgr) getClosingActivity(activityId int64) *pb.OperateActivity {
+	value, ok := m.closingActivities.Load(activityId)
+	if !ok {
+		return nil
+	}
+	activity, _ := value.(*pb.OperateActivity)
+	if activity == nil || nowTimestamp() >= activity.GetCloseDuration() {
 		return nil
 	}
-	_ = m.batchDelete(deleteList)
 	return activity
 }
 
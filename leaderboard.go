@@ -0,0 +1,185 @@
+/**
+ * @Author: dingqinghui
+ * @Description:排行榜
+ * @File:  leaderboard
+ * @Version: 1.0.0
+ * @Date: 2022/8/26 10:15
+ */
+
+package activity
+
+import (
+	"fmt"
+	"github.com/dingqinghui/activity/pb"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//
+// LeaderboardBackend
+// @Description: 排行榜存储,积分从高到低排序,积分相同时先达到的在前,多进程部署时可替换为redis等共享存储
+//
+type LeaderboardBackend interface {
+	// Update 更新玩家积分
+	Update(key string, playerId int32, score int64) error
+	// Rank 获取玩家名次(从1开始)和积分,未上榜返回0
+	Rank(key string, playerId int32) (int32, int64, error)
+	// Range 获取从start(从0开始)开始的count个条目
+	Range(key string, start int32, count int32) ([]*pb.OperateRankItem, error)
+	// Count 获取上榜人数
+	Count(key string) (int32, error)
+	// DeletePrefix 删除所有指定前缀的排行榜
+	DeletePrefix(prefix string) error
+}
+
+//
+// rankingKey
+// @Description: 排行榜key,按活动和区服区分
+// @param activityId
+// @param areaId
+// @param day
+// @param tplIndex
+// @return string
+//
+func rankingKey(activityId int64, areaId int32, day int32, tplIndex int32) string {
+	return fmt.Sprintf("%srank:%d:%d:%d", activityCounterPrefix(activityId), areaId, day, tplIndex)
+}
+
+type rankEntry struct {
+	playerId int32
+	score    int64
+	seq      int64 // 更新序号,积分相同时序号小的在前
+}
+
+//
+// before
+// @Description: 是否排在other之前
+// @receiver m
+// @param other
+// @return bool
+//
+func (m *rankEntry) before(other *rankEntry) bool {
+	if m.score != other.score {
+		return m.score > other.score
+	}
+	return m.seq < other.seq
+}
+
+//
+// rankBoard
+// @Description: 单个排行榜,条目按名次排序并按玩家Id索引
+//
+type rankBoard struct {
+	entries []*rankEntry
+	players map[int32]*rankEntry
+	seq     int64
+}
+
+//
+// index
+// @Description: 二分查找条目所在位置
+// @receiver m
+// @param entry
+// @return int
+//
+func (m *rankBoard) index(entry *rankEntry) int {
+	return sort.Search(len(m.entries), func(i int) bool {
+		return !m.entries[i].before(entry)
+	})
+}
+
+//
+// MemoryLeaderboard
+// @Description: 进程内排行榜
+//
+type MemoryLeaderboard struct {
+	sync.Mutex
+	boards map[string]*rankBoard
+}
+
+func NewMemoryLeaderboard() *MemoryLeaderboard {
+	return &MemoryLeaderboard{boards: make(map[string]*rankBoard)}
+}
+
+func (m *MemoryLeaderboard) Update(key string, playerId int32, score int64) error {
+	m.Lock()
+	defer m.Unlock()
+	board, ok := m.boards[key]
+	if !ok {
+		board = &rankBoard{players: make(map[int32]*rankEntry)}
+		m.boards[key] = board
+	}
+	if old, ok := board.players[playerId]; ok {
+		if old.score == score {
+			return nil
+		}
+		i := board.index(old)
+		board.entries = append(board.entries[:i], board.entries[i+1:]...)
+	}
+	// 新序号最大,插入到相同积分之后
+	board.seq++
+	entry := &rankEntry{playerId: playerId, score: score, seq: board.seq}
+	i := board.index(entry)
+	board.entries = append(board.entries, nil)
+	copy(board.entries[i+1:], board.entries[i:])
+	board.entries[i] = entry
+	board.players[playerId] = entry
+	return nil
+}
+
+func (m *MemoryLeaderboard) Rank(key string, playerId int32) (int32, int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	board, ok := m.boards[key]
+	if !ok {
+		return 0, 0, nil
+	}
+	entry, ok := board.players[playerId]
+	if !ok {
+		return 0, 0, nil
+	}
+	return int32(board.index(entry) + 1), entry.score, nil
+}
+
+func (m *MemoryLeaderboard) Range(key string, start int32, count int32) ([]*pb.OperateRankItem, error) {
+	m.Lock()
+	defer m.Unlock()
+	var board []*rankEntry
+	if rank, ok := m.boards[key]; ok {
+		board = rank.entries
+	}
+	if start < 0 || count <= 0 || int(start) >= len(board) {
+		return nil, nil
+	}
+	end := int(start + count)
+	if end > len(board) {
+		end = len(board)
+	}
+	items := make([]*pb.OperateRankItem, 0, end-int(start))
+	for i := int(start); i < end; i++ {
+		items = append(items, &pb.OperateRankItem{PlayerId: board[i].playerId, Rank: int32(i + 1), Score: board[i].score})
+	}
+	return items, nil
+}
+
+func (m *MemoryLeaderboard) Count(key string) (int32, error) {
+	m.Lock()
+	defer m.Unlock()
+	board, ok := m.boards[key]
+	if !ok {
+		return 0, nil
+	}
+	return int32(len(board.entries)), nil
+}
+
+func (m *MemoryLeaderboard) DeletePrefix(prefix string) error {
+	m.Lock()
+	defer m.Unlock()
+	for key := range m.boards {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.boards, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+/**
+ * @Author: dingqinghui
+ * @Description:奖励流水
+ * @File:  ledger
+ * @Version: 1.0.0
+ * @Date: 2022/8/12 11:02
+ */
+
+package activity
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// LedgerOp 流水操作类型
+	LedgerOp string
+	// LedgerSource 流水来源
+	LedgerSource string
+)
+
+// 流水操作类型
+const (
+	// LedgerOpGrant 发放奖励
+	LedgerOpGrant LedgerOp = "grant"
+	// LedgerOpCost 扣除消耗
+	LedgerOpCost LedgerOp = "cost"
+	// LedgerOpMail 邮件发放
+	LedgerOpMail LedgerOp = "mail"
+)
+
+// 流水来源
+const (
+	// LedgerSourceSignReward 领取签到奖励
+	LedgerSourceSignReward LedgerSource = "sign_reward"
+	// LedgerSourceSignRepair 补签
+	LedgerSourceSignRepair LedgerSource = "sign_repair"
+	// LedgerSourceStreakFreeze 连续签到断签保护
+	LedgerSourceStreakFreeze LedgerSource = "streak_freeze"
+	// LedgerSourceShopBuy 商城购买
+	LedgerSourceShopBuy LedgerSource = "shop_buy"
+	// LedgerSourceTaskReward 领取任务奖励
+	LedgerSourceTaskReward LedgerSource = "task_reward"
+	// LedgerSourceScoreReward 领取积分奖励
+	LedgerSourceScoreReward LedgerSource = "score_reward"
+	// LedgerSourceLotteryDraw 抽奖
+	LedgerSourceLotteryDraw LedgerSource = "lottery_draw"
+	// LedgerSourceCommunityReward 全服目标奖励
+	LedgerSourceCommunityReward LedgerSource = "community_reward"
+	// LedgerSourcePassReward 领取通行证等级奖励
+	LedgerSourcePassReward LedgerSource = "pass_reward"
+	// LedgerSourceRechargeReward 领取充值奖励
+	LedgerSourceRechargeReward LedgerSource = "recharge_reward"
+	// LedgerSourceRedeem 兑换码兑换
+	LedgerSourceRedeem LedgerSource = "redeem"
+	// LedgerSourceRankReward 排名奖励
+	LedgerSourceRankReward LedgerSource = "rank_reward"
+	// LedgerSourceDeleteMail 活动删除补发未领取奖励
+	LedgerSourceDeleteMail LedgerSource = "delete_mail"
+	// LedgerSourceResetMail 任务重置补发未领取奖励
+	LedgerSourceResetMail LedgerSource = "reset_mail"
+	// LedgerSourceCostRefund 奖励发放失败退还消耗
+	LedgerSourceCostRefund LedgerSource = "cost_refund"
+)
+
+//
+// LedgerRecord
+// @Description: 奖励流水记录
+//
+type LedgerRecord struct {
+	PlayerId   int32          `json:"playerId"`   // 玩家Id
+	ActivityId int64          `json:"activityId"` // 活动Id
+	Day        int32          `json:"day"`        // 模板所属天
+	TplIndex   int32          `json:"tplIndex"`   // 模板索引
+	Index      int32          `json:"index"`      // 签到天数/商品索引/任务索引/积分索引
+	Source     LedgerSource   `json:"source"`     // 来源
+	Op         LedgerOp       `json:"op"`         // 操作类型
+	Items      []*pb.ItemData `json:"items"`      // 道具
+	Timestamp  int64          `json:"timestamp"`  // 时间戳(s)
+	RequestId  string         `json:"requestId"`  // 请求Id
+}
+
+//
+// LedgerSink
+// @Description: 流水存储接口,只追加
+//
+type LedgerSink interface {
+	Append(record *LedgerRecord) error
+}
+
+//
+// LedgerQuerier
+// @Description: 流水查询接口
+//
+type LedgerQuerier interface {
+	// Query 查询玩家流水,activityId为0时查询玩家所有活动
+	Query(playerId int32, activityId int64) ([]*LedgerRecord, error)
+}
+
+// ledgerSink 流水存储,运行中可通过SetLedgerSink替换,值类型为ledgerSinkHolder
+var ledgerSink atomic.Value
+
+// ledgerSinkHolder atomic.Value不能存储nil和不同类型的值,包装后存储
+type ledgerSinkHolder struct {
+	sink LedgerSink
+}
+
+func setLedgerSink(sink LedgerSink) {
+	ledgerSink.Store(ledgerSinkHolder{sink: sink})
+}
+
+func getLedgerSink() LedgerSink {
+	holder, _ := ledgerSink.Load().(ledgerSinkHolder)
+	return holder.sink
+}
+
+//
+// ledgerTrace
+// @Description: 流水追踪信息
+//
+type ledgerTrace struct {
+	activityId int64
+	day        int32
+	tplIndex   int32
+	index      int32
+	source     LedgerSource
+}
+
+func newLedgerTrace(activityId int64, source LedgerSource, index int32) *ledgerTrace {
+	return &ledgerTrace{activityId: activityId, source: source, index: index}
+}
+
+//
+// appendLedger
+// @Description: 写入流水
+// @param playerId
+// @param requestId
+// @param trace
+// @param op
+// @param items
+//
+func appendLedger(playerId int32, requestId string, trace *ledgerTrace, op LedgerOp, items []*pb.ItemData) {
+	sink := getLedgerSink()
+	if sink == nil || len(items) <= 0 {
+		return
+	}
+	record := &LedgerRecord{
+		PlayerId:   playerId,
+		ActivityId: trace.activityId,
+		Day:        trace.day,
+		TplIndex:   trace.tplIndex,
+		Index:      trace.index,
+		Source:     trace.source,
+		Op:         op,
+		Items:      items,
+		Timestamp:  nowTimestamp(),
+		RequestId:  requestId,
+	}
+	if err := sink.Append(record); err != nil {
+		logError("写入奖励流水失败", zap.Error(err), zap.Any("record", record))
+	}
+}
+
+func matchLedger(record *LedgerRecord, playerId int32, activityId int64) bool {
+	if record.PlayerId != playerId {
+		return false
+	}
+	return activityId == 0 || record.ActivityId == activityId
+}
+
+//
+// MemoryLedger
+// @Description: 内存流水存储
+//
+type MemoryLedger struct {
+	sync.RWMutex
+	records []*LedgerRecord
+}
+
+func NewMemoryLedger() *MemoryLedger {
+	return &MemoryLedger{}
+}
+
+func (m *MemoryLedger) Append(record *LedgerRecord) error {
+	m.Lock()
+	defer m.Unlock()
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *MemoryLedger) Query(playerId int32, activityId int64) ([]*LedgerRecord, error) {
+	m.RLock()
+	defer m.RUnlock()
+	var list []*LedgerRecord
+	for _, record := range m.records {
+		if matchLedger(record, playerId, activityId) {
+			list = append(list, record)
+		}
+	}
+	return list, nil
+}
+
+//
+// FileLedger
+// @Description: 文件流水存储,每行一条json记录
+//
+type FileLedger struct {
+	sync.Mutex
+	path string
+	file *os.File
+}
+
+func NewFileLedger(path string) (*FileLedger, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileLedger{path: path, file: file}, nil
+}
+
+func (m *FileLedger) Append(record *LedgerRecord) error {
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	_, err = m.file.Write(append(buf, '\n'))
+	return err
+}
+
+func (m *FileLedger) Query(playerId int32, activityId int64) ([]*LedgerRecord, error) {
+	m.Lock()
+	defer m.Unlock()
+	file, err := os.Open(m.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var list []*LedgerRecord
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		record := &LedgerRecord{}
+		if err = json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, err
+		}
+		if matchLedger(record, playerId, activityId) {
+			list = append(list, record)
+		}
+	}
+	return list, scanner.Err()
+}
+
+func (m *FileLedger) Close() error {
+	m.Lock()
+	defer m.Unlock()
+	return m.file.Close()
+}
@@ -0,0 +1,331 @@
+/**
+ * @Author: dingqinghui
+ * @Description:监控指标
+ * @File:  metrics
+ * @Version: 1.0.0
+ * @Date: 2022/8/15 15:40
+ */
+
+package activity
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 指标名
+const (
+	metricOperationTotal       = "activity_operation_total"
+	metricOperationErrorsTotal = "activity_operation_errors_total"
+	metricCheckNewAndDelete    = "activity_check_new_and_delete_seconds"
+	metricGlobalAddTotal       = "activity_global_add_total"
+	metricGlobalDeleteTotal    = "activity_global_delete_total"
+	metricGlobalExpireTotal    = "activity_global_expire_total"
+	metricGlobalActivities     = "activity_global_activities"
+)
+
+// defaultBuckets 默认直方图分桶(s)
+var defaultBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1}
+
+//
+// Counter
+// @Description: 计数器,只增不减
+//
+type Counter interface {
+	Inc()
+	Add(v float64)
+}
+
+//
+// Gauge
+// @Description: 仪表盘,可增可减
+//
+type Gauge interface {
+	Set(v float64)
+	Add(v float64)
+}
+
+//
+// Histogram
+// @Description: 直方图
+//
+type Histogram interface {
+	Observe(v float64)
+}
+
+//
+// MetricsRegistry
+// @Description: 指标注册器,labels为key,value交替的标签列表
+//
+type MetricsRegistry interface {
+	Counter(name, help string, labels ...string) Counter
+	Gauge(name, help string, labels ...string) Gauge
+	Histogram(name, help string, buckets []float64, labels ...string) Histogram
+}
+
+// metricsRegistry 指标注册器,默认使用进程内注册器
+var metricsRegistry MetricsRegistry = NewRegistry()
+
+func getMetricsRegistry() MetricsRegistry {
+	return metricsRegistry
+}
+
+//
+// observeOperation
+// @Description: 记录玩家操作结果
+// @param op 操作名
+// @param activityId
+// @param err
+//
+func observeOperation(op string, activityId int64, err error) {
+	code := ErrorCode(err).String()
+	activity := strconv.FormatInt(activityId, 10)
+	getMetricsRegistry().Counter(metricOperationTotal, "玩家活动操作次数",
+		"op", op, "activity", activity, "code", code).Inc()
+	if err != nil {
+		getMetricsRegistry().Counter(metricOperationErrorsTotal, "玩家活动操作失败次数",
+			"op", op, "code", code).Inc()
+	}
+}
+
+//
+// observeDuration
+// @Description: 记录耗时
+// @param name
+// @param help
+// @param start
+//
+func observeDuration(name, help string, start time.Time) {
+	getMetricsRegistry().Histogram(name, help, defaultBuckets).Observe(time.Since(start).Seconds())
+}
+
+func incCounter(name, help string) {
+	getMetricsRegistry().Counter(name, help).Inc()
+}
+
+func addGauge(name, help string, v float64) {
+	getMetricsRegistry().Gauge(name, help).Add(v)
+}
+
+// 指标类型
+const (
+	metricTypeCounter   = "counter"
+	metricTypeGauge     = "gauge"
+	metricTypeHistogram = "histogram"
+)
+
+//
+// Registry
+// @Description: 进程内默认指标注册器,支持导出Prometheus文本格式
+//
+type Registry struct {
+	sync.Mutex
+	families map[string]*metricFamily
+}
+
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*metricFamily)}
+}
+
+//
+// metricFamily
+// @Description: 同名指标集合
+//
+type metricFamily struct {
+	name    string
+	help    string
+	typ     string
+	buckets []float64
+	series  map[string]*metricSeries
+}
+
+//
+// metricSeries
+// @Description: 单个标签组合的指标值
+//
+type metricSeries struct {
+	sync.Mutex
+	labels  string
+	value   float64
+	sum     float64
+	count   uint64
+	buckets []uint64
+	bounds  []float64
+}
+
+func (m *metricSeries) Inc() {
+	m.Add(1)
+}
+
+func (m *metricSeries) Add(v float64) {
+	m.Lock()
+	defer m.Unlock()
+	m.value += v
+}
+
+func (m *metricSeries) Set(v float64) {
+	m.Lock()
+	defer m.Unlock()
+	m.value = v
+}
+
+func (m *metricSeries) Observe(v float64) {
+	m.Lock()
+	defer m.Unlock()
+	m.sum += v
+	m.count++
+	for i, bound := range m.bounds {
+		if v <= bound {
+			m.buckets[i]++
+		}
+	}
+}
+
+func (m *Registry) Counter(name, help string, labels ...string) Counter {
+	return m.getSeries(name, help, metricTypeCounter, nil, labels)
+}
+
+func (m *Registry) Gauge(name, help string, labels ...string) Gauge {
+	return m.getSeries(name, help, metricTypeGauge, nil, labels)
+}
+
+func (m *Registry) Histogram(name, help string, buckets []float64, labels ...string) Histogram {
+	return m.getSeries(name, help, metricTypeHistogram, buckets, labels)
+}
+
+func (m *Registry) getSeries(name, help, typ string, buckets []float64, labels []string) *metricSeries {
+	m.Lock()
+	defer m.Unlock()
+	family, ok := m.families[name]
+	if !ok {
+		bounds := append([]float64(nil), buckets...)
+		sort.Float64s(bounds)
+		family = &metricFamily{
+			name:    name,
+			help:    help,
+			typ:     typ,
+			buckets: bounds,
+			series:  make(map[string]*metricSeries),
+		}
+		m.families[name] = family
+	}
+	key := formatLabels(labels)
+	series, ok := family.series[key]
+	if !ok {
+		series = &metricSeries{
+			labels:  key,
+			bounds:  family.buckets,
+			buckets: make([]uint64, len(family.buckets)),
+		}
+		family.series[key] = series
+	}
+	return series
+}
+
+//
+// WritePrometheus
+// @Description: 以Prometheus文本格式导出所有指标
+// @receiver m
+// @param w
+// @return error
+//
+func (m *Registry) WritePrometheus(w io.Writer) error {
+	m.Lock()
+	names := make([]string, 0, len(m.families))
+	for name := range m.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	families := make([]*metricFamily, 0, len(names))
+	seriesList := make([][]*metricSeries, 0, len(names))
+	for _, name := range names {
+		family := m.families[name]
+		keys := make([]string, 0, len(family.series))
+		for key := range family.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		list := make([]*metricSeries, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, family.series[key])
+		}
+		families = append(families, family)
+		seriesList = append(seriesList, list)
+	}
+	m.Unlock()
+
+	var b strings.Builder
+	for i, family := range families {
+		b.WriteString(fmt.Sprintf("# HELP %s %s\n", family.name, escapeHelp(family.help)))
+		b.WriteString(fmt.Sprintf("# TYPE %s %s\n", family.name, family.typ))
+		for _, series := range seriesList[i] {
+			series.Lock()
+			if family.typ == metricTypeHistogram {
+				for j, bound := range series.bounds {
+					b.WriteString(fmt.Sprintf("%s_bucket%s %d\n", family.name,
+						appendLabel(series.labels, "le", formatFloat(bound)), series.buckets[j]))
+				}
+				b.WriteString(fmt.Sprintf("%s_bucket%s %d\n", family.name, appendLabel(series.labels, "le", "+Inf"), series.count))
+				b.WriteString(fmt.Sprintf("%s_sum%s %s\n", family.name, series.labels, formatFloat(series.sum)))
+				b.WriteString(fmt.Sprintf("%s_count%s %d\n", family.name, series.labels, series.count))
+			} else {
+				b.WriteString(fmt.Sprintf("%s%s %s\n", family.name, series.labels, formatFloat(series.value)))
+			}
+			series.Unlock()
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+//
+// formatLabels
+// @Description: 标签格式化为{k1="v1",k2="v2"},按key排序
+// @param labels
+// @return string
+//
+func formatLabels(labels []string) string {
+	if len(labels) < 2 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], escapeLabelValue(labels[i+1])))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func appendLabel(labels string, key, value string) string {
+	pair := fmt.Sprintf(`%s="%s"`, key, value)
+	if labels == "" {
+		return "{" + pair + "}"
+	}
+	return labels[:len(labels)-1] + "," + pair + "}"
+}
+
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+func escapeHelp(v string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(v)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -326,9 +326,10 @@ type OperateGetTaskRewardS2C struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64   `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`        // 活动Id
-	TplIndex   int32   `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`            // 模板索引
-	TaskIndexs []int32 `protobuf:"varint,3,rep,packed,name=taskIndexs,proto3" json:"taskIndexs,omitempty"` //条目id
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	TaskIndexs []int32          `protobuf:"varint,3,rep,packed,name=taskIndexs,proto3" json:"taskIndexs,omitempty"`         //条目id
+	Code       OperateErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
 }
 
 func (x *OperateGetTaskRewardS2C) Reset() {
@@ -384,6 +385,13 @@ func (x *OperateGetTaskRewardS2C) GetTaskIndexs() []int32 {
 	return nil
 }
 
+func (x *OperateGetTaskRewardS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
 //签到
 type OperateSignC2S struct {
 	state         protoimpl.MessageState
@@ -446,8 +454,9 @@ type OperateSignS2C struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
-	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Code       OperateErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
 }
 
 func (x *OperateSignS2C) Reset() {
@@ -496,6 +505,13 @@ func (x *OperateSignS2C) GetTplIndex() int32 {
 	return 0
 }
 
+func (x *OperateSignS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
 //补签
 type OperateRepairSignC2S struct {
 	state         protoimpl.MessageState
@@ -504,6 +520,7 @@ type OperateRepairSignC2S struct {
 
 	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
 	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	Date       int32 `protobuf:"varint,3,opt,name=date,proto3" json:"date,omitempty"`             // 月历签到:补签日期(yyyymmdd)
 }
 
 func (x *OperateRepairSignC2S) Reset() {
@@ -552,14 +569,23 @@ func (x *OperateRepairSignC2S) GetTplIndex() int32 {
 	return 0
 }
 
+func (x *OperateRepairSignC2S) GetDate() int32 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
 //补签
 type OperateRepairSignS2C struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
-	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Code       OperateErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+	Date       int32            `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`                            // 月历签到:补签日期(yyyymmdd)
 }
 
 func (x *OperateRepairSignS2C) Reset() {
@@ -608,6 +634,20 @@ func (x *OperateRepairSignS2C) GetTplIndex() int32 {
 	return 0
 }
 
+func (x *OperateRepairSignS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+func (x *OperateRepairSignS2C) GetDate() int32 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
 //签到领奖
 type OperateSignGetRewardC2S struct {
 	state         protoimpl.MessageState
@@ -616,7 +656,7 @@ type OperateSignGetRewardC2S struct {
 
 	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
 	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
-	Day        int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`               // 领取哪天奖励
+	Day        int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`               // 领取哪天奖励,月历签到为日期(yyyymmdd)
 }
 
 func (x *OperateSignGetRewardC2S) Reset() {
@@ -678,9 +718,10 @@ type OperateSignGetRewardS2C struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
-	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
-	Day        int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`               // 领取哪天奖励
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Day        int32            `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`                              // 领取哪天奖励
+	Code       OperateErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
 }
 
 func (x *OperateSignGetRewardS2C) Reset() {
@@ -736,6 +777,13 @@ func (x *OperateSignGetRewardS2C) GetDay() int32 {
 	return 0
 }
 
+func (x *OperateSignGetRewardS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
 //购买商品
 type OperateShopBuyC2S struct {
 	state         protoimpl.MessageState
@@ -806,9 +854,11 @@ type OperateShopBuyS2C struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
-	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
-	GoodsIndex int32 `protobuf:"varint,3,opt,name=goodsIndex,proto3" json:"goodsIndex,omitempty"` // 商品索引
+	ActivityId int64              `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32              `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	GoodsIndex int32              `protobuf:"varint,3,opt,name=goodsIndex,proto3" json:"goodsIndex,omitempty"`                // 商品索引
+	Code       OperateErrorCode   `protobuf:"varint,4,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+	Price      *OperateGoodsPrice `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                           // 商品最新价格
 }
 
 func (x *OperateShopBuyS2C) Reset() {
@@ -864,6 +914,20 @@ func (x *OperateShopBuyS2C) GetGoodsIndex() int32 {
 	return 0
 }
 
+func (x *OperateShopBuyS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+func (x *OperateShopBuyS2C) GetPrice() *OperateGoodsPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
 //获取积分奖励
 type OperateGetScoreRewardC2S struct {
 	state         protoimpl.MessageState
@@ -926,8 +990,9 @@ type OperateGetScoreRewardS2C struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
-	ScoreIndex int32 `protobuf:"varint,2,opt,name=scoreIndex,proto3" json:"scoreIndex,omitempty"` // 积分索引
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	ScoreIndex int32            `protobuf:"varint,2,opt,name=scoreIndex,proto3" json:"scoreIndex,omitempty"`                // 积分索引
+	Code       OperateErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
 }
 
 func (x *OperateGetScoreRewardS2C) Reset() {
@@ -976,227 +1041,1705 @@ func (x *OperateGetScoreRewardS2C) GetScoreIndex() int32 {
 	return 0
 }
 
-var File_generate_operate_proto protoreflect.FileDescriptor
+func (x *OperateGetScoreRewardS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
 
-var file_generate_operate_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x1d,
-	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x13, 0x0a,
-	0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x32, 0x53, 0x22, 0x36, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x32, 0x43, 0x12, 0x21, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x0d, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x32, 0x43, 0x12, 0x21, 0x0a, 0x04, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x47,
-	0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x32, 0x43, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x52, 0x08, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x17, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x73, 0x22, 0x75, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73,
-	0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74,
-	0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4c, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x14, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x32,
-	0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x67, 0x0a,
-	0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0x67, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32,
-	0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22,
-	0x6f, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x42, 0x75,
-	0x79, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x6f, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x42,
-	0x75, 0x79, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0x5a, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5a, 0x0a,
-	0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+//抽奖
+type OperateLotteryDrawC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId   int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`     // 活动Id
+	TplIndex     int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`         // 模板索引
+	LotteryIndex int32 `protobuf:"varint,3,opt,name=lotteryIndex,proto3" json:"lotteryIndex,omitempty"` // 抽奖索引
 }
 
-var (
-	file_generate_operate_proto_rawDescOnce sync.Once
-	file_generate_operate_proto_rawDescData = file_generate_operate_proto_rawDesc
-)
+func (x *OperateLotteryDrawC2S) Reset() {
+	*x = OperateLotteryDrawC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_generate_operate_proto_rawDescGZIP() []byte {
-	file_generate_operate_proto_rawDescOnce.Do(func() {
-		file_generate_operate_proto_rawDescData = protoimpl.X.CompressGZIP(file_generate_operate_proto_rawDescData)
-	})
-	return file_generate_operate_proto_rawDescData
+func (x *OperateLotteryDrawC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_generate_operate_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_generate_operate_proto_goTypes = []interface{}{
-	(*OperateGetListC2S)(nil),        // 0: Game.OperateGetListC2S
-	(*OperateGetListS2C)(nil),        // 1: Game.OperateGetListS2C
-	(*OperateNewS2C)(nil),            // 2: Game.OperateNewS2C
-	(*OperateUpdateS2C)(nil),         // 3: Game.OperateUpdateS2C
-	(*OperateDeleteS2C)(nil),         // 4: Game.OperateDeleteS2C
-	(*OperateGetTaskRewardC2S)(nil),  // 5: Game.OperateGetTaskRewardC2S
-	(*OperateGetTaskRewardS2C)(nil),  // 6: Game.OperateGetTaskRewardS2C
-	(*OperateSignC2S)(nil),           // 7: Game.OperateSignC2S
-	(*OperateSignS2C)(nil),           // 8: Game.OperateSignS2C
-	(*OperateRepairSignC2S)(nil),     // 9: Game.OperateRepairSignC2S
-	(*OperateRepairSignS2C)(nil),     // 10: Game.OperateRepairSignS2C
-	(*OperateSignGetRewardC2S)(nil),  // 11: Game.OperateSignGetRewardC2S
-	(*OperateSignGetRewardS2C)(nil),  // 12: Game.OperateSignGetRewardS2C
-	(*OperateShopBuyC2S)(nil),        // 13: Game.OperateShopBuyC2S
-	(*OperateShopBuyS2C)(nil),        // 14: Game.OperateShopBuyS2C
-	(*OperateGetScoreRewardC2S)(nil), // 15: Game.OperateGetScoreRewardC2S
-	(*OperateGetScoreRewardS2C)(nil), // 16: Game.OperateGetScoreRewardS2C
-	(*Operate)(nil),                  // 17: Game.Operate
-	(*OperateActivityDB)(nil),        // 18: Game.OperateActivityDB
+func (*OperateLotteryDrawC2S) ProtoMessage() {}
+
+func (x *OperateLotteryDrawC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_generate_operate_proto_depIdxs = []int32{
-	17, // 0: Game.OperateGetListS2C.list:type_name -> Game.Operate
-	17, // 1: Game.OperateNewS2C.list:type_name -> Game.Operate
-	18, // 2: Game.OperateUpdateS2C.detailed:type_name -> Game.OperateActivityDB
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+// Deprecated: Use OperateLotteryDrawC2S.ProtoReflect.Descriptor instead.
+func (*OperateLotteryDrawC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_generate_operate_proto_init() }
-func file_generate_operate_proto_init() {
-	if File_generate_operate_proto != nil {
-		return
+func (x *OperateLotteryDrawC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
 	}
-	file_global_operate_activity_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_generate_operate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateGetListC2S); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_generate_operate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateGetListS2C); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_generate_operate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateNewS2C); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_generate_operate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateUpdateS2C); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_generate_operate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateDeleteS2C); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *OperateLotteryDrawC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateLotteryDrawC2S) GetLotteryIndex() int32 {
+	if x != nil {
+		return x.LotteryIndex
+	}
+	return 0
+}
+
+//抽奖
+type OperateLotteryDrawS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId   int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex     int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	LotteryIndex int32            `protobuf:"varint,3,opt,name=lotteryIndex,proto3" json:"lotteryIndex,omitempty"`            // 抽奖索引
+	Rewards      []*ItemData      `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`                       // 抽奖获得道具
+	Code         OperateErrorCode `protobuf:"varint,5,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperateLotteryDrawS2C) Reset() {
+	*x = OperateLotteryDrawS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateLotteryDrawS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateLotteryDrawS2C) ProtoMessage() {}
+
+func (x *OperateLotteryDrawS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_generate_operate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateGetTaskRewardC2S); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateLotteryDrawS2C.ProtoReflect.Descriptor instead.
+func (*OperateLotteryDrawS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OperateLotteryDrawS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateLotteryDrawS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateLotteryDrawS2C) GetLotteryIndex() int32 {
+	if x != nil {
+		return x.LotteryIndex
+	}
+	return 0
+}
+
+func (x *OperateLotteryDrawS2C) GetRewards() []*ItemData {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *OperateLotteryDrawS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//领取全服目标奖励
+type OperateCommunityClaimC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId     int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`         // 活动Id
+	TplIndex       int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`             // 模板索引
+	MilestoneIndex int32 `protobuf:"varint,3,opt,name=milestoneIndex,proto3" json:"milestoneIndex,omitempty"` // 档位索引
+}
+
+func (x *OperateCommunityClaimC2S) Reset() {
+	*x = OperateCommunityClaimC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateCommunityClaimC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateCommunityClaimC2S) ProtoMessage() {}
+
+func (x *OperateCommunityClaimC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateCommunityClaimC2S.ProtoReflect.Descriptor instead.
+func (*OperateCommunityClaimC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OperateCommunityClaimC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateCommunityClaimC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateCommunityClaimC2S) GetMilestoneIndex() int32 {
+	if x != nil {
+		return x.MilestoneIndex
+	}
+	return 0
+}
+
+//领取全服目标奖励
+type OperateCommunityClaimS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId     int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex       int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	MilestoneIndex int32            `protobuf:"varint,3,opt,name=milestoneIndex,proto3" json:"milestoneIndex,omitempty"`        // 档位索引
+	Code           OperateErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperateCommunityClaimS2C) Reset() {
+	*x = OperateCommunityClaimS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateCommunityClaimS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateCommunityClaimS2C) ProtoMessage() {}
+
+func (x *OperateCommunityClaimS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateCommunityClaimS2C.ProtoReflect.Descriptor instead.
+func (*OperateCommunityClaimS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OperateCommunityClaimS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateCommunityClaimS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateCommunityClaimS2C) GetMilestoneIndex() int32 {
+	if x != nil {
+		return x.MilestoneIndex
+	}
+	return 0
+}
+
+func (x *OperateCommunityClaimS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//领取通行证奖励
+type OperatePassClaimC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
+	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	Level      int32 `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`           // 等级索引,小于0时领取所有可领取奖励
+}
+
+func (x *OperatePassClaimC2S) Reset() {
+	*x = OperatePassClaimC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePassClaimC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePassClaimC2S) ProtoMessage() {}
+
+func (x *OperatePassClaimC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePassClaimC2S.ProtoReflect.Descriptor instead.
+func (*OperatePassClaimC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OperatePassClaimC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperatePassClaimC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperatePassClaimC2S) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+//领取通行证奖励
+type OperatePassClaimS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Level      int32            `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                          // 等级索引
+	Rewards    []*ItemData      `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`                       // 领取的奖励
+	Code       OperateErrorCode `protobuf:"varint,5,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperatePassClaimS2C) Reset() {
+	*x = OperatePassClaimS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePassClaimS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePassClaimS2C) ProtoMessage() {}
+
+func (x *OperatePassClaimS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePassClaimS2C.ProtoReflect.Descriptor instead.
+func (*OperatePassClaimS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OperatePassClaimS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperatePassClaimS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperatePassClaimS2C) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *OperatePassClaimS2C) GetRewards() []*ItemData {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *OperatePassClaimS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//购买高级通行证
+type OperatePassBuyPremiumC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
+	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+}
+
+func (x *OperatePassBuyPremiumC2S) Reset() {
+	*x = OperatePassBuyPremiumC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePassBuyPremiumC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePassBuyPremiumC2S) ProtoMessage() {}
+
+func (x *OperatePassBuyPremiumC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePassBuyPremiumC2S.ProtoReflect.Descriptor instead.
+func (*OperatePassBuyPremiumC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OperatePassBuyPremiumC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperatePassBuyPremiumC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+//购买高级通行证
+type OperatePassBuyPremiumS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Code       OperateErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperatePassBuyPremiumS2C) Reset() {
+	*x = OperatePassBuyPremiumS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePassBuyPremiumS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePassBuyPremiumS2C) ProtoMessage() {}
+
+func (x *OperatePassBuyPremiumS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePassBuyPremiumS2C.ProtoReflect.Descriptor instead.
+func (*OperatePassBuyPremiumS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OperatePassBuyPremiumS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperatePassBuyPremiumS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperatePassBuyPremiumS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//领取充值奖励
+type OperateRechargeClaimC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
+	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	TierIndex  int32 `protobuf:"varint,3,opt,name=tierIndex,proto3" json:"tierIndex,omitempty"`   // 档位索引
+}
+
+func (x *OperateRechargeClaimC2S) Reset() {
+	*x = OperateRechargeClaimC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRechargeClaimC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRechargeClaimC2S) ProtoMessage() {}
+
+func (x *OperateRechargeClaimC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRechargeClaimC2S.ProtoReflect.Descriptor instead.
+func (*OperateRechargeClaimC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OperateRechargeClaimC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateRechargeClaimC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateRechargeClaimC2S) GetTierIndex() int32 {
+	if x != nil {
+		return x.TierIndex
+	}
+	return 0
+}
+
+//领取充值奖励
+type OperateRechargeClaimS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64            `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32            `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	TierIndex  int32            `protobuf:"varint,3,opt,name=tierIndex,proto3" json:"tierIndex,omitempty"`                  // 档位索引
+	Code       OperateErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperateRechargeClaimS2C) Reset() {
+	*x = OperateRechargeClaimS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRechargeClaimS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRechargeClaimS2C) ProtoMessage() {}
+
+func (x *OperateRechargeClaimS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRechargeClaimS2C.ProtoReflect.Descriptor instead.
+func (*OperateRechargeClaimS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OperateRechargeClaimS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateRechargeClaimS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateRechargeClaimS2C) GetTierIndex() int32 {
+	if x != nil {
+		return x.TierIndex
+	}
+	return 0
+}
+
+func (x *OperateRechargeClaimS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//兑换码兑换
+type OperateRedeemC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedeemCode string `protobuf:"bytes,1,opt,name=redeemCode,proto3" json:"redeemCode,omitempty"` // 兑换码
+}
+
+func (x *OperateRedeemC2S) Reset() {
+	*x = OperateRedeemC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRedeemC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRedeemC2S) ProtoMessage() {}
+
+func (x *OperateRedeemC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRedeemC2S.ProtoReflect.Descriptor instead.
+func (*OperateRedeemC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OperateRedeemC2S) GetRedeemCode() string {
+	if x != nil {
+		return x.RedeemCode
+	}
+	return ""
+}
+
+//兑换码兑换
+type OperateRedeemS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedeemCode string           `protobuf:"bytes,1,opt,name=redeemCode,proto3" json:"redeemCode,omitempty"`                 // 兑换码
+	Rewards    []*ItemData      `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`                       // 获得的奖励
+	Code       OperateErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperateRedeemS2C) Reset() {
+	*x = OperateRedeemS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRedeemS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRedeemS2C) ProtoMessage() {}
+
+func (x *OperateRedeemS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRedeemS2C.ProtoReflect.Descriptor instead.
+func (*OperateRedeemS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OperateRedeemS2C) GetRedeemCode() string {
+	if x != nil {
+		return x.RedeemCode
+	}
+	return ""
+}
+
+func (x *OperateRedeemS2C) GetRewards() []*ItemData {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *OperateRedeemS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//排行榜分页
+type OperateRankListC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
+	TplIndex   int32 `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`     // 模板索引
+	Page       int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`             // 页码,从1开始
+	PageSize   int32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`     // 每页数量
+}
+
+func (x *OperateRankListC2S) Reset() {
+	*x = OperateRankListC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRankListC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRankListC2S) ProtoMessage() {}
+
+func (x *OperateRankListC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRankListC2S.ProtoReflect.Descriptor instead.
+func (*OperateRankListC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OperateRankListC2S) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateRankListC2S) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateRankListC2S) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OperateRankListC2S) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+//排行榜分页
+type OperateRankListS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64              `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"`                // 活动Id
+	TplIndex   int32              `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                    // 模板索引
+	Page       int32              `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                            // 页码
+	Items      []*OperateRankItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                           // 排行榜条目
+	Total      int32              `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`                          // 上榜人数
+	SelfRank   int32              `protobuf:"varint,6,opt,name=selfRank,proto3" json:"selfRank,omitempty"`                    // 自己名次,0:未上榜
+	SelfScore  int64              `protobuf:"varint,7,opt,name=selfScore,proto3" json:"selfScore,omitempty"`                  // 自己积分
+	Code       OperateErrorCode   `protobuf:"varint,8,opt,name=code,proto3,enum=Game.OperateErrorCode" json:"code,omitempty"` // 错误码
+}
+
+func (x *OperateRankListS2C) Reset() {
+	*x = OperateRankListS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRankListS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRankListS2C) ProtoMessage() {}
+
+func (x *OperateRankListS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRankListS2C.ProtoReflect.Descriptor instead.
+func (*OperateRankListS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OperateRankListS2C) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetItems() []*OperateRankItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OperateRankListS2C) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetSelfRank() int32 {
+	if x != nil {
+		return x.SelfRank
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetSelfScore() int64 {
+	if x != nil {
+		return x.SelfScore
+	}
+	return 0
+}
+
+func (x *OperateRankListS2C) GetCode() OperateErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return OperateErrorCode_OEC_SUCCESS
+}
+
+//模板红点信息
+type OperateNotifyTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TplIndex            int32                `protobuf:"varint,1,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`                                        // 模板索引
+	TemplateType        ActivityTemplateType `protobuf:"varint,2,opt,name=templateType,proto3,enum=Game.ActivityTemplateType" json:"templateType,omitempty"` // 模板类型
+	TaskCount           int32                `protobuf:"varint,3,opt,name=taskCount,proto3" json:"taskCount,omitempty"`                                      // 可领取任务数量
+	SignRewardCount     int32                `protobuf:"varint,4,opt,name=signRewardCount,proto3" json:"signRewardCount,omitempty"`                          // 未领取签到奖励天数
+	CanSign             bool                 `protobuf:"varint,5,opt,name=canSign,proto3" json:"canSign,omitempty"`                                          // 今日是否可签到
+	GoodsCount          int32                `protobuf:"varint,6,opt,name=goodsCount,proto3" json:"goodsCount,omitempty"`                                    // 可购买商品数量
+	LotteryCount        int32                `protobuf:"varint,7,opt,name=lotteryCount,proto3" json:"lotteryCount,omitempty"`                                // 道具足够的抽奖数量
+	MilestoneCount      int32                `protobuf:"varint,8,opt,name=milestoneCount,proto3" json:"milestoneCount,omitempty"`                            // 可领取全服目标档位数量
+	PassRewardCount     int32                `protobuf:"varint,9,opt,name=passRewardCount,proto3" json:"passRewardCount,omitempty"`                          // 可领取通行证奖励数量
+	RechargeRewardCount int32                `protobuf:"varint,10,opt,name=rechargeRewardCount,proto3" json:"rechargeRewardCount,omitempty"`                 // 可领取充值奖励次数
+}
+
+func (x *OperateNotifyTemplate) Reset() {
+	*x = OperateNotifyTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateNotifyTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateNotifyTemplate) ProtoMessage() {}
+
+func (x *OperateNotifyTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateNotifyTemplate.ProtoReflect.Descriptor instead.
+func (*OperateNotifyTemplate) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OperateNotifyTemplate) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetTemplateType() ActivityTemplateType {
+	if x != nil {
+		return x.TemplateType
+	}
+	return ActivityTemplateType_ATP_INVALID
+}
+
+func (x *OperateNotifyTemplate) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetSignRewardCount() int32 {
+	if x != nil {
+		return x.SignRewardCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetCanSign() bool {
+	if x != nil {
+		return x.CanSign
+	}
+	return false
+}
+
+func (x *OperateNotifyTemplate) GetGoodsCount() int32 {
+	if x != nil {
+		return x.GoodsCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetLotteryCount() int32 {
+	if x != nil {
+		return x.LotteryCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetMilestoneCount() int32 {
+	if x != nil {
+		return x.MilestoneCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetPassRewardCount() int32 {
+	if x != nil {
+		return x.PassRewardCount
+	}
+	return 0
+}
+
+func (x *OperateNotifyTemplate) GetRechargeRewardCount() int32 {
+	if x != nil {
+		return x.RechargeRewardCount
+	}
+	return 0
+}
+
+//活动红点信息
+type OperateNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId int64                    `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,omitempty"` // 活动Id
+	Templates  []*OperateNotifyTemplate `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`    // 模板红点信息
+	ScoreCount int32                    `protobuf:"varint,3,opt,name=scoreCount,proto3" json:"scoreCount,omitempty"` // 可领取积分奖励数量
+}
+
+func (x *OperateNotify) Reset() {
+	*x = OperateNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateNotify) ProtoMessage() {}
+
+func (x *OperateNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateNotify.ProtoReflect.Descriptor instead.
+func (*OperateNotify) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *OperateNotify) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateNotify) GetTemplates() []*OperateNotifyTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *OperateNotify) GetScoreCount() int32 {
+	if x != nil {
+		return x.ScoreCount
+	}
+	return 0
+}
+
+//获取运营活动红点
+type OperateNotifyC2S struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OperateNotifyC2S) Reset() {
+	*x = OperateNotifyC2S{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateNotifyC2S) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateNotifyC2S) ProtoMessage() {}
+
+func (x *OperateNotifyC2S) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateNotifyC2S.ProtoReflect.Descriptor instead.
+func (*OperateNotifyC2S) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{33}
+}
+
+//获取运营活动红点
+type OperateNotifyS2C struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*OperateNotify `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` //活动红点信息
+}
+
+func (x *OperateNotifyS2C) Reset() {
+	*x = OperateNotifyS2C{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_generate_operate_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateNotifyS2C) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateNotifyS2C) ProtoMessage() {}
+
+func (x *OperateNotifyS2C) ProtoReflect() protoreflect.Message {
+	mi := &file_generate_operate_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateNotifyS2C.ProtoReflect.Descriptor instead.
+func (*OperateNotifyS2C) Descriptor() ([]byte, []int) {
+	return file_generate_operate_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OperateNotifyS2C) GetList() []*OperateNotify {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_generate_operate_proto protoreflect.FileDescriptor
+
+var file_generate_operate_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x1d,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x13, 0x0a,
+	0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x32, 0x53, 0x22, 0x36, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x32, 0x43, 0x12, 0x21, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x0d, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x32, 0x43, 0x12, 0x21, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x47,
+	0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x32, 0x43, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x52, 0x08, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x17, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32, 0x43, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x78, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x66,
+	0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x32, 0x43, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x67, 0x0a, 0x17, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x64, 0x61, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32, 0x43,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03,
+	0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x2a,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x42, 0x75, 0x79, 0x43, 0x32, 0x53, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
+	0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xca, 0x01, 0x0a, 0x11,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x42, 0x75, 0x79, 0x53, 0x32,
+	0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x86, 0x01, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x32,
+	0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x77, 0x0a,
+	0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x44,
+	0x72, 0x61, 0x77, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xcd, 0x01, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x44, 0x72, 0x61, 0x77, 0x53, 0x32, 0x43,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7e, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43,
+	0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xaa, 0x01, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x26, 0x0a, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xbd, 0x01, 0x0a,
+	0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x56, 0x0a, 0x18,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x42, 0x75, 0x79, 0x50, 0x72,
+	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x42, 0x75, 0x79, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x32,
+	0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x73, 0x0a, 0x17, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9f,
+	0x01, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70,
+	0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x65, 0x72, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x32, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x80, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x32, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x6e, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x32, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x70, 0x6c,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6c,
+	0x66, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x9d, 0x03, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x73,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f,
+	0x6f, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x67, 0x6f, 0x6f, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x70, 0x61, 0x73, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x72,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x12, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x43, 0x32, 0x53, 0x22, 0x3b, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x53, 0x32, 0x43, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_generate_operate_proto_rawDescOnce sync.Once
+	file_generate_operate_proto_rawDescData = file_generate_operate_proto_rawDesc
+)
+
+func file_generate_operate_proto_rawDescGZIP() []byte {
+	file_generate_operate_proto_rawDescOnce.Do(func() {
+		file_generate_operate_proto_rawDescData = protoimpl.X.CompressGZIP(file_generate_operate_proto_rawDescData)
+	})
+	return file_generate_operate_proto_rawDescData
+}
+
+var file_generate_operate_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_generate_operate_proto_goTypes = []interface{}{
+	(*OperateGetListC2S)(nil),        // 0: Game.OperateGetListC2S
+	(*OperateGetListS2C)(nil),        // 1: Game.OperateGetListS2C
+	(*OperateNewS2C)(nil),            // 2: Game.OperateNewS2C
+	(*OperateUpdateS2C)(nil),         // 3: Game.OperateUpdateS2C
+	(*OperateDeleteS2C)(nil),         // 4: Game.OperateDeleteS2C
+	(*OperateGetTaskRewardC2S)(nil),  // 5: Game.OperateGetTaskRewardC2S
+	(*OperateGetTaskRewardS2C)(nil),  // 6: Game.OperateGetTaskRewardS2C
+	(*OperateSignC2S)(nil),           // 7: Game.OperateSignC2S
+	(*OperateSignS2C)(nil),           // 8: Game.OperateSignS2C
+	(*OperateRepairSignC2S)(nil),     // 9: Game.OperateRepairSignC2S
+	(*OperateRepairSignS2C)(nil),     // 10: Game.OperateRepairSignS2C
+	(*OperateSignGetRewardC2S)(nil),  // 11: Game.OperateSignGetRewardC2S
+	(*OperateSignGetRewardS2C)(nil),  // 12: Game.OperateSignGetRewardS2C
+	(*OperateShopBuyC2S)(nil),        // 13: Game.OperateShopBuyC2S
+	(*OperateShopBuyS2C)(nil),        // 14: Game.OperateShopBuyS2C
+	(*OperateGetScoreRewardC2S)(nil), // 15: Game.OperateGetScoreRewardC2S
+	(*OperateGetScoreRewardS2C)(nil), // 16: Game.OperateGetScoreRewardS2C
+	(*OperateLotteryDrawC2S)(nil),    // 17: Game.OperateLotteryDrawC2S
+	(*OperateLotteryDrawS2C)(nil),    // 18: Game.OperateLotteryDrawS2C
+	(*OperateCommunityClaimC2S)(nil), // 19: Game.OperateCommunityClaimC2S
+	(*OperateCommunityClaimS2C)(nil), // 20: Game.OperateCommunityClaimS2C
+	(*OperatePassClaimC2S)(nil),      // 21: Game.OperatePassClaimC2S
+	(*OperatePassClaimS2C)(nil),      // 22: Game.OperatePassClaimS2C
+	(*OperatePassBuyPremiumC2S)(nil), // 23: Game.OperatePassBuyPremiumC2S
+	(*OperatePassBuyPremiumS2C)(nil), // 24: Game.OperatePassBuyPremiumS2C
+	(*OperateRechargeClaimC2S)(nil),  // 25: Game.OperateRechargeClaimC2S
+	(*OperateRechargeClaimS2C)(nil),  // 26: Game.OperateRechargeClaimS2C
+	(*OperateRedeemC2S)(nil),         // 27: Game.OperateRedeemC2S
+	(*OperateRedeemS2C)(nil),         // 28: Game.OperateRedeemS2C
+	(*OperateRankListC2S)(nil),       // 29: Game.OperateRankListC2S
+	(*OperateRankListS2C)(nil),       // 30: Game.OperateRankListS2C
+	(*OperateNotifyTemplate)(nil),    // 31: Game.OperateNotifyTemplate
+	(*OperateNotify)(nil),            // 32: Game.OperateNotify
+	(*OperateNotifyC2S)(nil),         // 33: Game.OperateNotifyC2S
+	(*OperateNotifyS2C)(nil),         // 34: Game.OperateNotifyS2C
+	(*Operate)(nil),                  // 35: Game.Operate
+	(*OperateActivityDB)(nil),        // 36: Game.OperateActivityDB
+	(OperateErrorCode)(0),            // 37: Game.OperateErrorCode
+	(*OperateGoodsPrice)(nil),        // 38: Game.OperateGoodsPrice
+	(*ItemData)(nil),                 // 39: Game.ItemData
+	(*OperateRankItem)(nil),          // 40: Game.OperateRankItem
+	(ActivityTemplateType)(0),        // 41: Game.ActivityTemplateType
+}
+var file_generate_operate_proto_depIdxs = []int32{
+	35, // 0: Game.OperateGetListS2C.list:type_name -> Game.Operate
+	35, // 1: Game.OperateNewS2C.list:type_name -> Game.Operate
+	36, // 2: Game.OperateUpdateS2C.detailed:type_name -> Game.OperateActivityDB
+	37, // 3: Game.OperateGetTaskRewardS2C.code:type_name -> Game.OperateErrorCode
+	37, // 4: Game.OperateSignS2C.code:type_name -> Game.OperateErrorCode
+	37, // 5: Game.OperateRepairSignS2C.code:type_name -> Game.OperateErrorCode
+	37, // 6: Game.OperateSignGetRewardS2C.code:type_name -> Game.OperateErrorCode
+	37, // 7: Game.OperateShopBuyS2C.code:type_name -> Game.OperateErrorCode
+	38, // 8: Game.OperateShopBuyS2C.price:type_name -> Game.OperateGoodsPrice
+	37, // 9: Game.OperateGetScoreRewardS2C.code:type_name -> Game.OperateErrorCode
+	39, // 10: Game.OperateLotteryDrawS2C.rewards:type_name -> Game.ItemData
+	37, // 11: Game.OperateLotteryDrawS2C.code:type_name -> Game.OperateErrorCode
+	37, // 12: Game.OperateCommunityClaimS2C.code:type_name -> Game.OperateErrorCode
+	39, // 13: Game.OperatePassClaimS2C.rewards:type_name -> Game.ItemData
+	37, // 14: Game.OperatePassClaimS2C.code:type_name -> Game.OperateErrorCode
+	37, // 15: Game.OperatePassBuyPremiumS2C.code:type_name -> Game.OperateErrorCode
+	37, // 16: Game.OperateRechargeClaimS2C.code:type_name -> Game.OperateErrorCode
+	39, // 17: Game.OperateRedeemS2C.rewards:type_name -> Game.ItemData
+	37, // 18: Game.OperateRedeemS2C.code:type_name -> Game.OperateErrorCode
+	40, // 19: Game.OperateRankListS2C.items:type_name -> Game.OperateRankItem
+	37, // 20: Game.OperateRankListS2C.code:type_name -> Game.OperateErrorCode
+	41, // 21: Game.OperateNotifyTemplate.templateType:type_name -> Game.ActivityTemplateType
+	31, // 22: Game.OperateNotify.templates:type_name -> Game.OperateNotifyTemplate
+	32, // 23: Game.OperateNotifyS2C.list:type_name -> Game.OperateNotify
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_generate_operate_proto_init() }
+func file_generate_operate_proto_init() {
+	if File_generate_operate_proto != nil {
+		return
+	}
+	file_global_operate_activity_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_generate_operate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateGetListC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateGetListS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateNewS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateUpdateS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateDeleteS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateGetTaskRewardC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
@@ -1332,6 +2875,222 @@ func file_generate_operate_proto_init() {
 				return nil
 			}
 		}
+		file_generate_operate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateLotteryDrawC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateLotteryDrawS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateCommunityClaimC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateCommunityClaimS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePassClaimC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePassClaimS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePassBuyPremiumC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePassBuyPremiumS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRechargeClaimC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRechargeClaimS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRedeemC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRedeemS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRankListC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRankListS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateNotifyTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateNotify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateNotifyC2S); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_generate_operate_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateNotifyS2C); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1339,7 +3098,7 @@ func file_generate_operate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_generate_operate_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
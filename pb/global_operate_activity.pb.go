@@ -10,6 +10,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -83,22 +84,34 @@ type ActivityTemplateType int32
 
 const (
 	ActivityTemplateType_ATP_INVALID      ActivityTemplateType = 0
-	ActivityTemplateType_SIGN_IN_TYPE     ActivityTemplateType = 1 // 签到
-	ActivityTemplateType_CONDITION_TYPE   ActivityTemplateType = 2 // 条件
-	ActivityTemplateType_CONSUMPTION_TYPE ActivityTemplateType = 3 // 消费
-	ActivityTemplateType_LOTTERY_TYPE     ActivityTemplateType = 4 // 抽奖
-	ActivityTemplateType_SCORE_TYPE       ActivityTemplateType = 5 // 积分
+	ActivityTemplateType_SIGN_IN_TYPE     ActivityTemplateType = 1  // 签到
+	ActivityTemplateType_CONDITION_TYPE   ActivityTemplateType = 2  // 条件
+	ActivityTemplateType_CONSUMPTION_TYPE ActivityTemplateType = 3  // 消费
+	ActivityTemplateType_LOTTERY_TYPE     ActivityTemplateType = 4  // 抽奖
+	ActivityTemplateType_SCORE_TYPE       ActivityTemplateType = 5  // 积分
+	ActivityTemplateType_RANKING_TYPE     ActivityTemplateType = 6  // 排行
+	ActivityTemplateType_GUILD_TYPE       ActivityTemplateType = 7  // 公会
+	ActivityTemplateType_COMMUNITY_TYPE   ActivityTemplateType = 8  // 全服目标
+	ActivityTemplateType_BATTLE_PASS_TYPE ActivityTemplateType = 9  // 通行证
+	ActivityTemplateType_RECHARGE_TYPE    ActivityTemplateType = 10 // 充值
+	ActivityTemplateType_CHAIN_TYPE       ActivityTemplateType = 11 // 任务链
 )
 
 // Enum value maps for ActivityTemplateType.
 var (
 	ActivityTemplateType_name = map[int32]string{
-		0: "ATP_INVALID",
-		1: "SIGN_IN_TYPE",
-		2: "CONDITION_TYPE",
-		3: "CONSUMPTION_TYPE",
-		4: "LOTTERY_TYPE",
-		5: "SCORE_TYPE",
+		0:  "ATP_INVALID",
+		1:  "SIGN_IN_TYPE",
+		2:  "CONDITION_TYPE",
+		3:  "CONSUMPTION_TYPE",
+		4:  "LOTTERY_TYPE",
+		5:  "SCORE_TYPE",
+		6:  "RANKING_TYPE",
+		7:  "GUILD_TYPE",
+		8:  "COMMUNITY_TYPE",
+		9:  "BATTLE_PASS_TYPE",
+		10: "RECHARGE_TYPE",
+		11: "CHAIN_TYPE",
 	}
 	ActivityTemplateType_value = map[string]int32{
 		"ATP_INVALID":      0,
@@ -107,6 +120,12 @@ var (
 		"CONSUMPTION_TYPE": 3,
 		"LOTTERY_TYPE":     4,
 		"SCORE_TYPE":       5,
+		"RANKING_TYPE":     6,
+		"GUILD_TYPE":       7,
+		"COMMUNITY_TYPE":   8,
+		"BATTLE_PASS_TYPE": 9,
+		"RECHARGE_TYPE":    10,
+		"CHAIN_TYPE":       11,
 	}
 )
 
@@ -190,7 +209,522 @@ func (TaskRefreshType) EnumDescriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{2}
 }
 
-// 任务状态
+// 签到模式
+type SignInMode int32
+
+const (
+	SignInMode_SIM_CUMULATIVE SignInMode = 0 // 累计签到
+	SignInMode_SIM_STREAK     SignInMode = 1 // 连续签到
+	SignInMode_SIM_CALENDAR   SignInMode = 2 // 月历签到
+)
+
+// Enum value maps for SignInMode.
+var (
+	SignInMode_name = map[int32]string{
+		0: "SIM_CUMULATIVE",
+		1: "SIM_STREAK",
+		2: "SIM_CALENDAR",
+	}
+	SignInMode_value = map[string]int32{
+		"SIM_CUMULATIVE": 0,
+		"SIM_STREAK":     1,
+		"SIM_CALENDAR":   2,
+	}
+)
+
+func (x SignInMode) Enum() *SignInMode {
+	p := new(SignInMode)
+	*p = x
+	return p
+}
+
+func (x SignInMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignInMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[3].Descriptor()
+}
+
+func (SignInMode) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[3]
+}
+
+func (x SignInMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignInMode.Descriptor instead.
+func (SignInMode) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{3}
+}
+
+// 错误码
+type OperateErrorCode int32
+
+const (
+	OperateErrorCode_OEC_SUCCESS                       OperateErrorCode = 0    // 成功
+	OperateErrorCode_OEC_UNKNOWN                       OperateErrorCode = 1    // 未知错误
+	OperateErrorCode_OEC_PARAM_ERROR                   OperateErrorCode = 2    // 参数错误
+	OperateErrorCode_OEC_CONF_ERROR                    OperateErrorCode = 3    // 配置错误
+	OperateErrorCode_OEC_DB_ERROR                      OperateErrorCode = 4    // 存档数据错误
+	OperateErrorCode_OEC_UNKNOWN_MSG                   OperateErrorCode = 5    // 未知协议
+	OperateErrorCode_OEC_ACTIVITY_NOT_EXIST            OperateErrorCode = 6    // 活动不存在
+	OperateErrorCode_OEC_ACTIVITY_NOT_OPEN             OperateErrorCode = 7    // 活动未到开启时间
+	OperateErrorCode_OEC_PRE_CONDITION_NOT_FINISH      OperateErrorCode = 8    // 活动前置条件未满足
+	OperateErrorCode_OEC_TEMPLATE_NOT_EXIST            OperateErrorCode = 9    // 模板不存在
+	OperateErrorCode_OEC_COST_NOT_ENOUGH               OperateErrorCode = 10   // 消耗不足
+	OperateErrorCode_OEC_SUB_COST_FAIL                 OperateErrorCode = 11   // 扣除消耗失败
+	OperateErrorCode_OEC_ADD_REWARD_FAIL               OperateErrorCode = 12   // 发放奖励失败
+	OperateErrorCode_OEC_DEPENDENCY_NOT_FINISH         OperateErrorCode = 13   // 依赖活动未完成
+	OperateErrorCode_OEC_SIGN_TRIGGER_ERROR            OperateErrorCode = 100  // 签到触发类型错误
+	OperateErrorCode_OEC_SIGN_TODAY_SIGNED             OperateErrorCode = 101  // 今日已签到
+	OperateErrorCode_OEC_SIGN_COUNT_LIMIT              OperateErrorCode = 102  // 签到次数已达上限
+	OperateErrorCode_OEC_SIGN_NOT_SIGNED               OperateErrorCode = 103  // 未签到
+	OperateErrorCode_OEC_SIGN_REWARD_GOT               OperateErrorCode = 104  // 签到奖励已领取
+	OperateErrorCode_OEC_REPAIR_COUNT_LIMIT            OperateErrorCode = 105  // 补签次数已达上限
+	OperateErrorCode_OEC_REPAIR_DAY_COUNT_LIMIT        OperateErrorCode = 106  // 每日补签次数已达上限
+	OperateErrorCode_OEC_REPAIR_TASK_NOT_FINISH        OperateErrorCode = 107  // 补签任务未完成
+	OperateErrorCode_OEC_SIGN_MODE_ERROR               OperateErrorCode = 108  // 签到模式不支持该操作
+	OperateErrorCode_OEC_SIGN_DATE_ERROR               OperateErrorCode = 109  // 补签日期错误
+	OperateErrorCode_OEC_SIGN_DATE_SIGNED              OperateErrorCode = 110  // 该日期已签到
+	OperateErrorCode_OEC_SHOP_GOODS_NOT_EXIST          OperateErrorCode = 200  // 商品不存在
+	OperateErrorCode_OEC_SHOP_GOODS_LIMIT              OperateErrorCode = 201  // 商品已达限购次数
+	OperateErrorCode_OEC_SHOP_STOCK_NOT_ENOUGH         OperateErrorCode = 202  // 全服库存不足
+	OperateErrorCode_OEC_TASK_NOT_EXIST                OperateErrorCode = 300  // 任务不存在
+	OperateErrorCode_OEC_TASK_STATE_ERROR              OperateErrorCode = 301  // 任务状态错误
+	OperateErrorCode_OEC_SCORE_NOT_EXIST               OperateErrorCode = 400  // 积分奖励不存在
+	OperateErrorCode_OEC_SCORE_REWARD_GOT              OperateErrorCode = 401  // 积分奖励已领取
+	OperateErrorCode_OEC_LOTTERY_NOT_EXIST             OperateErrorCode = 500  // 抽奖不存在
+	OperateErrorCode_OEC_LOTTERY_POOL_EMPTY            OperateErrorCode = 501  // 奖池为空
+	OperateErrorCode_OEC_GUILD_NOT_JOINED              OperateErrorCode = 600  // 未加入公会
+	OperateErrorCode_OEC_GUILD_CONTRIBUTION_NOT_ENOUGH OperateErrorCode = 601  // 公会贡献不足
+	OperateErrorCode_OEC_MILESTONE_NOT_EXIST           OperateErrorCode = 700  // 全服目标档位不存在
+	OperateErrorCode_OEC_MILESTONE_NOT_REACHED         OperateErrorCode = 701  // 全服目标档位未达成
+	OperateErrorCode_OEC_MILESTONE_CLAIMED             OperateErrorCode = 702  // 全服目标档位奖励已领取
+	OperateErrorCode_OEC_MILESTONE_NOT_ELIGIBLE        OperateErrorCode = 703  // 个人贡献不足,不能领取全服目标奖励
+	OperateErrorCode_OEC_PASS_LEVEL_NOT_EXIST          OperateErrorCode = 800  // 通行证等级不存在
+	OperateErrorCode_OEC_PASS_LEVEL_NOT_REACHED        OperateErrorCode = 801  // 通行证等级未达到
+	OperateErrorCode_OEC_PASS_REWARD_CLAIMED           OperateErrorCode = 802  // 通行证等级奖励已领取
+	OperateErrorCode_OEC_PASS_PREMIUM_UNLOCKED         OperateErrorCode = 803  // 高级通行证已解锁
+	OperateErrorCode_OEC_PASS_PURCHASE_FAIL            OperateErrorCode = 804  // 高级通行证购买失败
+	OperateErrorCode_OEC_RECHARGE_TIER_NOT_EXIST       OperateErrorCode = 900  // 充值档位不存在
+	OperateErrorCode_OEC_RECHARGE_TIER_NOT_REACHED     OperateErrorCode = 901  // 充值档位未达成
+	OperateErrorCode_OEC_RECHARGE_TIER_CLAIMED         OperateErrorCode = 902  // 充值档位奖励已领取
+	OperateErrorCode_OEC_RECHARGE_ORDER_DUPLICATE      OperateErrorCode = 903  // 充值订单重复
+	OperateErrorCode_OEC_RECHARGE_NOT_MATCH            OperateErrorCode = 904  // 没有进行中且货币类型匹配的充值模板
+	OperateErrorCode_OEC_REDEEM_CODE_NOT_EXIST         OperateErrorCode = 1000 // 兑换码不存在
+	OperateErrorCode_OEC_REDEEM_CODE_EXPIRED           OperateErrorCode = 1001 // 兑换码不在有效期内
+	OperateErrorCode_OEC_REDEEM_CODE_USED_UP           OperateErrorCode = 1002 // 兑换码使用次数已用完
+	OperateErrorCode_OEC_REDEEM_PLAYER_LIMIT           OperateErrorCode = 1003 // 玩家兑换次数已达上限
+	OperateErrorCode_OEC_REDEEM_RESTRICTED             OperateErrorCode = 1004 // 兑换码不能在当前渠道/区服使用
+)
+
+// Enum value maps for OperateErrorCode.
+var (
+	OperateErrorCode_name = map[int32]string{
+		0:    "OEC_SUCCESS",
+		1:    "OEC_UNKNOWN",
+		2:    "OEC_PARAM_ERROR",
+		3:    "OEC_CONF_ERROR",
+		4:    "OEC_DB_ERROR",
+		5:    "OEC_UNKNOWN_MSG",
+		6:    "OEC_ACTIVITY_NOT_EXIST",
+		7:    "OEC_ACTIVITY_NOT_OPEN",
+		8:    "OEC_PRE_CONDITION_NOT_FINISH",
+		9:    "OEC_TEMPLATE_NOT_EXIST",
+		10:   "OEC_COST_NOT_ENOUGH",
+		11:   "OEC_SUB_COST_FAIL",
+		12:   "OEC_ADD_REWARD_FAIL",
+		13:   "OEC_DEPENDENCY_NOT_FINISH",
+		100:  "OEC_SIGN_TRIGGER_ERROR",
+		101:  "OEC_SIGN_TODAY_SIGNED",
+		102:  "OEC_SIGN_COUNT_LIMIT",
+		103:  "OEC_SIGN_NOT_SIGNED",
+		104:  "OEC_SIGN_REWARD_GOT",
+		105:  "OEC_REPAIR_COUNT_LIMIT",
+		106:  "OEC_REPAIR_DAY_COUNT_LIMIT",
+		107:  "OEC_REPAIR_TASK_NOT_FINISH",
+		108:  "OEC_SIGN_MODE_ERROR",
+		109:  "OEC_SIGN_DATE_ERROR",
+		110:  "OEC_SIGN_DATE_SIGNED",
+		200:  "OEC_SHOP_GOODS_NOT_EXIST",
+		201:  "OEC_SHOP_GOODS_LIMIT",
+		202:  "OEC_SHOP_STOCK_NOT_ENOUGH",
+		300:  "OEC_TASK_NOT_EXIST",
+		301:  "OEC_TASK_STATE_ERROR",
+		400:  "OEC_SCORE_NOT_EXIST",
+		401:  "OEC_SCORE_REWARD_GOT",
+		500:  "OEC_LOTTERY_NOT_EXIST",
+		501:  "OEC_LOTTERY_POOL_EMPTY",
+		600:  "OEC_GUILD_NOT_JOINED",
+		601:  "OEC_GUILD_CONTRIBUTION_NOT_ENOUGH",
+		700:  "OEC_MILESTONE_NOT_EXIST",
+		701:  "OEC_MILESTONE_NOT_REACHED",
+		702:  "OEC_MILESTONE_CLAIMED",
+		703:  "OEC_MILESTONE_NOT_ELIGIBLE",
+		800:  "OEC_PASS_LEVEL_NOT_EXIST",
+		801:  "OEC_PASS_LEVEL_NOT_REACHED",
+		802:  "OEC_PASS_REWARD_CLAIMED",
+		803:  "OEC_PASS_PREMIUM_UNLOCKED",
+		804:  "OEC_PASS_PURCHASE_FAIL",
+		900:  "OEC_RECHARGE_TIER_NOT_EXIST",
+		901:  "OEC_RECHARGE_TIER_NOT_REACHED",
+		902:  "OEC_RECHARGE_TIER_CLAIMED",
+		903:  "OEC_RECHARGE_ORDER_DUPLICATE",
+		904:  "OEC_RECHARGE_NOT_MATCH",
+		1000: "OEC_REDEEM_CODE_NOT_EXIST",
+		1001: "OEC_REDEEM_CODE_EXPIRED",
+		1002: "OEC_REDEEM_CODE_USED_UP",
+		1003: "OEC_REDEEM_PLAYER_LIMIT",
+		1004: "OEC_REDEEM_RESTRICTED",
+	}
+	OperateErrorCode_value = map[string]int32{
+		"OEC_SUCCESS":                       0,
+		"OEC_UNKNOWN":                       1,
+		"OEC_PARAM_ERROR":                   2,
+		"OEC_CONF_ERROR":                    3,
+		"OEC_DB_ERROR":                      4,
+		"OEC_UNKNOWN_MSG":                   5,
+		"OEC_ACTIVITY_NOT_EXIST":            6,
+		"OEC_ACTIVITY_NOT_OPEN":             7,
+		"OEC_PRE_CONDITION_NOT_FINISH":      8,
+		"OEC_TEMPLATE_NOT_EXIST":            9,
+		"OEC_COST_NOT_ENOUGH":               10,
+		"OEC_SUB_COST_FAIL":                 11,
+		"OEC_ADD_REWARD_FAIL":               12,
+		"OEC_DEPENDENCY_NOT_FINISH":         13,
+		"OEC_SIGN_TRIGGER_ERROR":            100,
+		"OEC_SIGN_TODAY_SIGNED":             101,
+		"OEC_SIGN_COUNT_LIMIT":              102,
+		"OEC_SIGN_NOT_SIGNED":               103,
+		"OEC_SIGN_REWARD_GOT":               104,
+		"OEC_REPAIR_COUNT_LIMIT":            105,
+		"OEC_REPAIR_DAY_COUNT_LIMIT":        106,
+		"OEC_REPAIR_TASK_NOT_FINISH":        107,
+		"OEC_SIGN_MODE_ERROR":               108,
+		"OEC_SIGN_DATE_ERROR":               109,
+		"OEC_SIGN_DATE_SIGNED":              110,
+		"OEC_SHOP_GOODS_NOT_EXIST":          200,
+		"OEC_SHOP_GOODS_LIMIT":              201,
+		"OEC_SHOP_STOCK_NOT_ENOUGH":         202,
+		"OEC_TASK_NOT_EXIST":                300,
+		"OEC_TASK_STATE_ERROR":              301,
+		"OEC_SCORE_NOT_EXIST":               400,
+		"OEC_SCORE_REWARD_GOT":              401,
+		"OEC_LOTTERY_NOT_EXIST":             500,
+		"OEC_LOTTERY_POOL_EMPTY":            501,
+		"OEC_GUILD_NOT_JOINED":              600,
+		"OEC_GUILD_CONTRIBUTION_NOT_ENOUGH": 601,
+		"OEC_MILESTONE_NOT_EXIST":           700,
+		"OEC_MILESTONE_NOT_REACHED":         701,
+		"OEC_MILESTONE_CLAIMED":             702,
+		"OEC_MILESTONE_NOT_ELIGIBLE":        703,
+		"OEC_PASS_LEVEL_NOT_EXIST":          800,
+		"OEC_PASS_LEVEL_NOT_REACHED":        801,
+		"OEC_PASS_REWARD_CLAIMED":           802,
+		"OEC_PASS_PREMIUM_UNLOCKED":         803,
+		"OEC_PASS_PURCHASE_FAIL":            804,
+		"OEC_RECHARGE_TIER_NOT_EXIST":       900,
+		"OEC_RECHARGE_TIER_NOT_REACHED":     901,
+		"OEC_RECHARGE_TIER_CLAIMED":         902,
+		"OEC_RECHARGE_ORDER_DUPLICATE":      903,
+		"OEC_RECHARGE_NOT_MATCH":            904,
+		"OEC_REDEEM_CODE_NOT_EXIST":         1000,
+		"OEC_REDEEM_CODE_EXPIRED":           1001,
+		"OEC_REDEEM_CODE_USED_UP":           1002,
+		"OEC_REDEEM_PLAYER_LIMIT":           1003,
+		"OEC_REDEEM_RESTRICTED":             1004,
+	}
+)
+
+func (x OperateErrorCode) Enum() *OperateErrorCode {
+	p := new(OperateErrorCode)
+	*p = x
+	return p
+}
+
+func (x OperateErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperateErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[4].Descriptor()
+}
+
+func (OperateErrorCode) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[4]
+}
+
+func (x OperateErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperateErrorCode.Descriptor instead.
+func (OperateErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{4}
+}
+
+// 邮件原因
+type OperateMailReason int32
+
+const (
+	OperateMailReason_OMR_NONE         OperateMailReason = 0
+	OperateMailReason_OMR_ACTIVITY_END OperateMailReason = 1 // 活动结束补发未领取奖励
+	OperateMailReason_OMR_TASK_RESET   OperateMailReason = 2 // 任务重置补发未领取奖励
+	OperateMailReason_OMR_RANK_SETTLE  OperateMailReason = 3 // 排名结算奖励
+)
+
+// Enum value maps for OperateMailReason.
+var (
+	OperateMailReason_name = map[int32]string{
+		0: "OMR_NONE",
+		1: "OMR_ACTIVITY_END",
+		2: "OMR_TASK_RESET",
+		3: "OMR_RANK_SETTLE",
+	}
+	OperateMailReason_value = map[string]int32{
+		"OMR_NONE":         0,
+		"OMR_ACTIVITY_END": 1,
+		"OMR_TASK_RESET":   2,
+		"OMR_RANK_SETTLE":  3,
+	}
+)
+
+func (x OperateMailReason) Enum() *OperateMailReason {
+	p := new(OperateMailReason)
+	*p = x
+	return p
+}
+
+func (x OperateMailReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperateMailReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[5].Descriptor()
+}
+
+func (OperateMailReason) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[5]
+}
+
+func (x OperateMailReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperateMailReason.Descriptor instead.
+func (OperateMailReason) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{5}
+}
+
+// 邮件来源类型
+type OperateMailSourceType int32
+
+const (
+	OperateMailSourceType_OMST_TEMPLATE OperateMailSourceType = 0 // 模板
+	OperateMailSourceType_OMST_TASK     OperateMailSourceType = 1 // 模板中的任务
+	OperateMailSourceType_OMST_SCORE    OperateMailSourceType = 2 // 积分奖励
+	OperateMailSourceType_OMST_RANK     OperateMailSourceType = 3 // 排名奖励
+	OperateMailSourceType_OMST_PRE_TASK OperateMailSourceType = 4 // 前置任务
+)
+
+// Enum value maps for OperateMailSourceType.
+var (
+	OperateMailSourceType_name = map[int32]string{
+		0: "OMST_TEMPLATE",
+		1: "OMST_TASK",
+		2: "OMST_SCORE",
+		3: "OMST_RANK",
+		4: "OMST_PRE_TASK",
+	}
+	OperateMailSourceType_value = map[string]int32{
+		"OMST_TEMPLATE": 0,
+		"OMST_TASK":     1,
+		"OMST_SCORE":    2,
+		"OMST_RANK":     3,
+		"OMST_PRE_TASK": 4,
+	}
+)
+
+func (x OperateMailSourceType) Enum() *OperateMailSourceType {
+	p := new(OperateMailSourceType)
+	*p = x
+	return p
+}
+
+func (x OperateMailSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperateMailSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[6].Descriptor()
+}
+
+func (OperateMailSourceType) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[6]
+}
+
+func (x OperateMailSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperateMailSourceType.Descriptor instead.
+func (OperateMailSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{6}
+}
+
+// 活动依赖类型
+type ActivityDependType int32
+
+const (
+	ActivityDependType_ADT_COMPLETE    ActivityDependType = 0 // 依赖活动完成(任务奖励和积分奖励全部领取)
+	ActivityDependType_ADT_FINAL_SCORE ActivityDependType = 1 // 依赖活动最后一档积分奖励已领取
+)
+
+// Enum value maps for ActivityDependType.
+var (
+	ActivityDependType_name = map[int32]string{
+		0: "ADT_COMPLETE",
+		1: "ADT_FINAL_SCORE",
+	}
+	ActivityDependType_value = map[string]int32{
+		"ADT_COMPLETE":    0,
+		"ADT_FINAL_SCORE": 1,
+	}
+)
+
+func (x ActivityDependType) Enum() *ActivityDependType {
+	p := new(ActivityDependType)
+	*p = x
+	return p
+}
+
+func (x ActivityDependType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityDependType) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[7].Descriptor()
+}
+
+func (ActivityDependType) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[7]
+}
+
+func (x ActivityDependType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityDependType.Descriptor instead.
+func (ActivityDependType) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{7}
+}
+
+// 前置条件节点类型
+type PreCondNodeType int32
+
+const (
+	PreCondNodeType_PCN_LEAF     PreCondNodeType = 0 // 叶子节点,对应一个前置任务
+	PreCondNodeType_PCN_AND      PreCondNodeType = 1 // 子节点全部完成
+	PreCondNodeType_PCN_OR       PreCondNodeType = 2 // 子节点完成一个
+	PreCondNodeType_PCN_NOT      PreCondNodeType = 3 // 子节点未完成(只取第一个子节点)
+	PreCondNodeType_PCN_AT_LEAST PreCondNodeType = 4 // 子节点至少完成Count个
+)
+
+// Enum value maps for PreCondNodeType.
+var (
+	PreCondNodeType_name = map[int32]string{
+		0: "PCN_LEAF",
+		1: "PCN_AND",
+		2: "PCN_OR",
+		3: "PCN_NOT",
+		4: "PCN_AT_LEAST",
+	}
+	PreCondNodeType_value = map[string]int32{
+		"PCN_LEAF":     0,
+		"PCN_AND":      1,
+		"PCN_OR":       2,
+		"PCN_NOT":      3,
+		"PCN_AT_LEAST": 4,
+	}
+)
+
+func (x PreCondNodeType) Enum() *PreCondNodeType {
+	p := new(PreCondNodeType)
+	*p = x
+	return p
+}
+
+func (x PreCondNodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreCondNodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[8].Descriptor()
+}
+
+func (PreCondNodeType) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[8]
+}
+
+func (x PreCondNodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreCondNodeType.Descriptor instead.
+func (PreCondNodeType) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{8}
+}
+
+// 充值档位类型
+type RechargeTierType int32
+
+const (
+	RechargeTierType_RTT_CUMULATIVE RechargeTierType = 0 // 累计充值达到金额
+	RechargeTierType_RTT_SINGLE     RechargeTierType = 1 // 单笔充值达到金额
+)
+
+// Enum value maps for RechargeTierType.
+var (
+	RechargeTierType_name = map[int32]string{
+		0: "RTT_CUMULATIVE",
+		1: "RTT_SINGLE",
+	}
+	RechargeTierType_value = map[string]int32{
+		"RTT_CUMULATIVE": 0,
+		"RTT_SINGLE":     1,
+	}
+)
+
+func (x RechargeTierType) Enum() *RechargeTierType {
+	p := new(RechargeTierType)
+	*p = x
+	return p
+}
+
+func (x RechargeTierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RechargeTierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_global_operate_activity_proto_enumTypes[9].Descriptor()
+}
+
+func (RechargeTierType) Type() protoreflect.EnumType {
+	return &file_global_operate_activity_proto_enumTypes[9]
+}
+
+func (x RechargeTierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RechargeTierType.Descriptor instead.
+func (RechargeTierType) EnumDescriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{9}
+}
+
+//任务状态
 type OperateTaskState int32
 
 const (
@@ -224,11 +758,11 @@ func (x OperateTaskState) String() string {
 }
 
 func (OperateTaskState) Descriptor() protoreflect.EnumDescriptor {
-	return file_global_operate_activity_proto_enumTypes[3].Descriptor()
+	return file_global_operate_activity_proto_enumTypes[10].Descriptor()
 }
 
 func (OperateTaskState) Type() protoreflect.EnumType {
-	return &file_global_operate_activity_proto_enumTypes[3]
+	return &file_global_operate_activity_proto_enumTypes[10]
 }
 
 func (x OperateTaskState) Number() protoreflect.EnumNumber {
@@ -237,7 +771,7 @@ func (x OperateTaskState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperateTaskState.Descriptor instead.
 func (OperateTaskState) EnumDescriptor() ([]byte, []int) {
-	return file_global_operate_activity_proto_rawDescGZIP(), []int{3}
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{10}
 }
 
 // 道具（货币）通过结构
@@ -395,6 +929,9 @@ type OperateActivity struct {
 	ClientUI               string                  `protobuf:"bytes,19,opt,name=ClientUI,proto3" json:"ClientUI,omitempty"`                                                                                                  //客户端UI
 	Sort                   int32                   `protobuf:"varint,20,opt,name=Sort,proto3" json:"Sort,omitempty"`                                                                                                         //排序值
 	NeedPreCondAllFinished bool                    `protobuf:"varint,21,opt,name=NeedPreCondAllFinished,proto3" json:"NeedPreCondAllFinished,omitempty"`                                                                     // 前置条件是否需要全部完成 true:全部完成 false：完成一个
+	PreCondTree            *PreCondNode            `protobuf:"bytes,22,opt,name=PreCondTree,proto3" json:"PreCondTree,omitempty"`                                                                                            // 前置条件表达式树,设置后替代NeedPreCondAllFinished组合规则,叶子节点引用PreConditionGroup中的任务
+	Dependencies           []*ActivityDependency   `protobuf:"bytes,23,rep,name=Dependencies,proto3" json:"Dependencies,omitempty"`                                                                                          // 依赖活动,全部满足后才对玩家开放
+	Mails                  []*OperateMailConf      `protobuf:"bytes,24,rep,name=Mails,proto3" json:"Mails,omitempty"`                                                                                                        // 邮件标题/正文多语言key配置
 }
 
 func (x *OperateActivity) Reset() {
@@ -576,16 +1113,40 @@ func (x *OperateActivity) GetNeedPreCondAllFinished() bool {
 	return false
 }
 
-type ConditionGroup struct {
+func (x *OperateActivity) GetPreCondTree() *PreCondNode {
+	if x != nil {
+		return x.PreCondTree
+	}
+	return nil
+}
+
+func (x *OperateActivity) GetDependencies() []*ActivityDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *OperateActivity) GetMails() []*OperateMailConf {
+	if x != nil {
+		return x.Mails
+	}
+	return nil
+}
+
+// 邮件配置
+type OperateMailConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreCondition []*Condition `protobuf:"bytes,1,rep,name=PreCondition,proto3" json:"PreCondition,omitempty"` // 活动前置条件
+	Reason   OperateMailReason `protobuf:"varint,1,opt,name=Reason,proto3,enum=Game.OperateMailReason" json:"Reason,omitempty"` // 邮件原因
+	TitleKey string            `protobuf:"bytes,2,opt,name=TitleKey,proto3" json:"TitleKey,omitempty"`                          // 标题多语言key
+	BodyKey  string            `protobuf:"bytes,3,opt,name=BodyKey,proto3" json:"BodyKey,omitempty"`                            // 正文多语言key
 }
 
-func (x *ConditionGroup) Reset() {
-	*x = ConditionGroup{}
+func (x *OperateMailConf) Reset() {
+	*x = OperateMailConf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,13 +1154,13 @@ func (x *ConditionGroup) Reset() {
 	}
 }
 
-func (x *ConditionGroup) String() string {
+func (x *OperateMailConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConditionGroup) ProtoMessage() {}
+func (*OperateMailConf) ProtoMessage() {}
 
-func (x *ConditionGroup) ProtoReflect() protoreflect.Message {
+func (x *OperateMailConf) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,29 +1172,50 @@ func (x *ConditionGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConditionGroup.ProtoReflect.Descriptor instead.
-func (*ConditionGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use OperateMailConf.ProtoReflect.Descriptor instead.
+func (*OperateMailConf) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ConditionGroup) GetPreCondition() []*Condition {
+func (x *OperateMailConf) GetReason() OperateMailReason {
 	if x != nil {
-		return x.PreCondition
+		return x.Reason
 	}
-	return nil
+	return OperateMailReason_OMR_NONE
 }
 
-// 活动列表
-type ActivityList struct {
+func (x *OperateMailConf) GetTitleKey() string {
+	if x != nil {
+		return x.TitleKey
+	}
+	return ""
+}
+
+func (x *OperateMailConf) GetBodyKey() string {
+	if x != nil {
+		return x.BodyKey
+	}
+	return ""
+}
+
+// 活动邮件,同一活动同一次发放的所有来源合并为一封
+type OperateMail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*ActivityTemplate `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"` // 活动模板列表
+	ActivityId   int64                `protobuf:"varint,1,opt,name=ActivityId,proto3" json:"ActivityId,omitempty"`                         // 活动Id
+	Reason       OperateMailReason    `protobuf:"varint,2,opt,name=Reason,proto3,enum=Game.OperateMailReason" json:"Reason,omitempty"`     // 邮件原因
+	ActivityName string               `protobuf:"bytes,3,opt,name=ActivityName,proto3" json:"ActivityName,omitempty"`                      // 活动名字
+	TitleKey     string               `protobuf:"bytes,4,opt,name=TitleKey,proto3" json:"TitleKey,omitempty"`                              // 标题多语言key,未配置时为空
+	BodyKey      string               `protobuf:"bytes,5,opt,name=BodyKey,proto3" json:"BodyKey,omitempty"`                                // 正文多语言key,未配置时为空
+	Sources      []*OperateMailSource `protobuf:"bytes,6,rep,name=Sources,proto3" json:"Sources,omitempty"`                                // 奖励来源
+	Items        []*ItemData          `protobuf:"bytes,7,rep,name=Items,proto3" json:"Items,omitempty"`                                    // 合并后的奖励
+	ResetType    TaskRefreshType      `protobuf:"varint,8,opt,name=ResetType,proto3,enum=Game.TaskRefreshType" json:"ResetType,omitempty"` // 任务重置类型,Reason为OMR_TASK_RESET时有效
 }
 
-func (x *ActivityList) Reset() {
-	*x = ActivityList{}
+func (x *OperateMail) Reset() {
+	*x = OperateMail{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,13 +1223,13 @@ func (x *ActivityList) Reset() {
 	}
 }
 
-func (x *ActivityList) String() string {
+func (x *OperateMail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityList) ProtoMessage() {}
+func (*OperateMail) ProtoMessage() {}
 
-func (x *ActivityList) ProtoReflect() protoreflect.Message {
+func (x *OperateMail) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -659,35 +1241,83 @@ func (x *ActivityList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityList.ProtoReflect.Descriptor instead.
-func (*ActivityList) Descriptor() ([]byte, []int) {
+// Deprecated: Use OperateMail.ProtoReflect.Descriptor instead.
+func (*OperateMail) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ActivityList) GetList() []*ActivityTemplate {
+func (x *OperateMail) GetActivityId() int64 {
 	if x != nil {
-		return x.List
+		return x.ActivityId
 	}
-	return nil
+	return 0
 }
 
-// 活动模板数据
-type ActivityTemplate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *OperateMail) GetReason() OperateMailReason {
+	if x != nil {
+		return x.Reason
+	}
+	return OperateMailReason_OMR_NONE
+}
 
-	Id           int64                `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`                                                    // 模板ID
-	Name         string               `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`                                                 // 模板名称
-	TemplateType ActivityTemplateType `protobuf:"varint,3,opt,name=TemplateType,proto3,enum=Game.ActivityTemplateType" json:"TemplateType,omitempty"` // 模板类型
-	SignIn       *SignInTemplate      `protobuf:"bytes,4,opt,name=SignIn,proto3" json:"SignIn,omitempty"`                                             // 签到
-	Condition    *ConditionTemplate   `protobuf:"bytes,5,opt,name=Condition,proto3" json:"Condition,omitempty"`                                       // 条件
-	Consumption  *ConsumptionTemplate `protobuf:"bytes,6,opt,name=Consumption,proto3" json:"Consumption,omitempty"`                                   // 消耗
-	Lottery      *LotteryTemplate     `protobuf:"bytes,7,opt,name=Lottery,proto3" json:"Lottery,omitempty"`                                           // 抽奖
+func (x *OperateMail) GetActivityName() string {
+	if x != nil {
+		return x.ActivityName
+	}
+	return ""
 }
 
-func (x *ActivityTemplate) Reset() {
-	*x = ActivityTemplate{}
+func (x *OperateMail) GetTitleKey() string {
+	if x != nil {
+		return x.TitleKey
+	}
+	return ""
+}
+
+func (x *OperateMail) GetBodyKey() string {
+	if x != nil {
+		return x.BodyKey
+	}
+	return ""
+}
+
+func (x *OperateMail) GetSources() []*OperateMailSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *OperateMail) GetItems() []*ItemData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OperateMail) GetResetType() TaskRefreshType {
+	if x != nil {
+		return x.ResetType
+	}
+	return TaskRefreshType_TRT_NOT
+}
+
+// 邮件奖励来源
+type OperateMailSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         OperateMailSourceType `protobuf:"varint,1,opt,name=Type,proto3,enum=Game.OperateMailSourceType" json:"Type,omitempty"`                // 来源类型
+	Day          int32                 `protobuf:"varint,2,opt,name=Day,proto3" json:"Day,omitempty"`                                                  // 模板所属天
+	TplIndex     int32                 `protobuf:"varint,3,opt,name=TplIndex,proto3" json:"TplIndex,omitempty"`                                        // 模板索引
+	TemplateType ActivityTemplateType  `protobuf:"varint,4,opt,name=TemplateType,proto3,enum=Game.ActivityTemplateType" json:"TemplateType,omitempty"` // 模板类型
+	Index        int32                 `protobuf:"varint,5,opt,name=Index,proto3" json:"Index,omitempty"`                                              // 任务索引/积分索引/名次
+	Items        []*ItemData           `protobuf:"bytes,6,rep,name=Items,proto3" json:"Items,omitempty"`                                               // 奖励
+}
+
+func (x *OperateMailSource) Reset() {
+	*x = OperateMailSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,13 +1325,13 @@ func (x *ActivityTemplate) Reset() {
 	}
 }
 
-func (x *ActivityTemplate) String() string {
+func (x *OperateMailSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityTemplate) ProtoMessage() {}
+func (*OperateMailSource) ProtoMessage() {}
 
-func (x *ActivityTemplate) ProtoReflect() protoreflect.Message {
+func (x *OperateMailSource) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,75 +1343,65 @@ func (x *ActivityTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityTemplate.ProtoReflect.Descriptor instead.
-func (*ActivityTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use OperateMailSource.ProtoReflect.Descriptor instead.
+func (*OperateMailSource) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ActivityTemplate) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ActivityTemplate) GetName() string {
+func (x *OperateMailSource) GetType() OperateMailSourceType {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
-	return ""
+	return OperateMailSourceType_OMST_TEMPLATE
 }
 
-func (x *ActivityTemplate) GetTemplateType() ActivityTemplateType {
+func (x *OperateMailSource) GetDay() int32 {
 	if x != nil {
-		return x.TemplateType
+		return x.Day
 	}
-	return ActivityTemplateType_ATP_INVALID
+	return 0
 }
 
-func (x *ActivityTemplate) GetSignIn() *SignInTemplate {
+func (x *OperateMailSource) GetTplIndex() int32 {
 	if x != nil {
-		return x.SignIn
+		return x.TplIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *ActivityTemplate) GetCondition() *ConditionTemplate {
+func (x *OperateMailSource) GetTemplateType() ActivityTemplateType {
 	if x != nil {
-		return x.Condition
+		return x.TemplateType
 	}
-	return nil
+	return ActivityTemplateType_ATP_INVALID
 }
 
-func (x *ActivityTemplate) GetConsumption() *ConsumptionTemplate {
+func (x *OperateMailSource) GetIndex() int32 {
 	if x != nil {
-		return x.Consumption
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *ActivityTemplate) GetLottery() *LotteryTemplate {
+func (x *OperateMailSource) GetItems() []*ItemData {
 	if x != nil {
-		return x.Lottery
+		return x.Items
 	}
 	return nil
 }
 
-// 条件结构(任务配置数据)
-type Condition struct {
+// 活动依赖
+type ActivityDependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition   int32           `protobuf:"varint,1,opt,name=Condition,proto3" json:"Condition,omitempty"`                               // 条件类型
-	ParamsList  []int32         `protobuf:"varint,2,rep,packed,name=ParamsList,proto3" json:"ParamsList,omitempty"`                      // 条件参数
-	Description string          `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`                            // 条件说明
-	RewardList  []*ItemData     `protobuf:"bytes,4,rep,name=RewardList,proto3" json:"RewardList,omitempty"`                              // 奖励列表
-	RefreshType TaskRefreshType `protobuf:"varint,5,opt,name=RefreshType,proto3,enum=Game.TaskRefreshType" json:"RefreshType,omitempty"` // 刷新类型
+	ActivityId int64              `protobuf:"varint,1,opt,name=ActivityId,proto3" json:"ActivityId,omitempty"`                  // 依赖活动Id
+	Type       ActivityDependType `protobuf:"varint,2,opt,name=Type,proto3,enum=Game.ActivityDependType" json:"Type,omitempty"` // 依赖类型
 }
 
-func (x *Condition) Reset() {
-	*x = Condition{}
+func (x *ActivityDependency) Reset() {
+	*x = ActivityDependency{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,13 +1409,13 @@ func (x *Condition) Reset() {
 	}
 }
 
-func (x *Condition) String() string {
+func (x *ActivityDependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Condition) ProtoMessage() {}
+func (*ActivityDependency) ProtoMessage() {}
 
-func (x *Condition) ProtoReflect() protoreflect.Message {
+func (x *ActivityDependency) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,58 +1427,40 @@ func (x *Condition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
-func (*Condition) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityDependency.ProtoReflect.Descriptor instead.
+func (*ActivityDependency) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Condition) GetCondition() int32 {
+func (x *ActivityDependency) GetActivityId() int64 {
 	if x != nil {
-		return x.Condition
+		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *Condition) GetParamsList() []int32 {
-	if x != nil {
-		return x.ParamsList
-	}
-	return nil
-}
-
-func (x *Condition) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Condition) GetRewardList() []*ItemData {
-	if x != nil {
-		return x.RewardList
-	}
-	return nil
-}
-
-func (x *Condition) GetRefreshType() TaskRefreshType {
+func (x *ActivityDependency) GetType() ActivityDependType {
 	if x != nil {
-		return x.RefreshType
+		return x.Type
 	}
-	return TaskRefreshType_TRT_NOT
+	return ActivityDependType_ADT_COMPLETE
 }
 
-// 补签规则
-type RepairSignInRule struct {
+// 前置条件节点
+type PreCondNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RSI_Expend    []*ItemData  `protobuf:"bytes,1,rep,name=RSI_Expend,json=RSIExpend,proto3" json:"RSI_Expend,omitempty"`          // 道具消耗补签
-	RSI_Condition []*Condition `protobuf:"bytes,2,rep,name=RSI_Condition,json=RSICondition,proto3" json:"RSI_Condition,omitempty"` // 条件补签
+	Type     PreCondNodeType `protobuf:"varint,1,opt,name=Type,proto3,enum=Game.PreCondNodeType" json:"Type,omitempty"` // 节点类型
+	Group    int32           `protobuf:"varint,2,opt,name=Group,proto3" json:"Group,omitempty"`                         // 叶子节点:PreConditionGroup索引
+	Index    int32           `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`                         // 叶子节点:PreCondition索引
+	Count    int32           `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`                         // AT_LEAST节点:需要完成的子节点数
+	Children []*PreCondNode  `protobuf:"bytes,5,rep,name=Children,proto3" json:"Children,omitempty"`                    // 子节点
 }
 
-func (x *RepairSignInRule) Reset() {
-	*x = RepairSignInRule{}
+func (x *PreCondNode) Reset() {
+	*x = PreCondNode{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,13 +1468,13 @@ func (x *RepairSignInRule) Reset() {
 	}
 }
 
-func (x *RepairSignInRule) String() string {
+func (x *PreCondNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepairSignInRule) ProtoMessage() {}
+func (*PreCondNode) ProtoMessage() {}
 
-func (x *RepairSignInRule) ProtoReflect() protoreflect.Message {
+func (x *PreCondNode) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,36 +1486,56 @@ func (x *RepairSignInRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepairSignInRule.ProtoReflect.Descriptor instead.
-func (*RepairSignInRule) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreCondNode.ProtoReflect.Descriptor instead.
+func (*PreCondNode) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RepairSignInRule) GetRSI_Expend() []*ItemData {
+func (x *PreCondNode) GetType() PreCondNodeType {
 	if x != nil {
-		return x.RSI_Expend
+		return x.Type
 	}
-	return nil
+	return PreCondNodeType_PCN_LEAF
 }
 
-func (x *RepairSignInRule) GetRSI_Condition() []*Condition {
+func (x *PreCondNode) GetGroup() int32 {
 	if x != nil {
-		return x.RSI_Condition
+		return x.Group
+	}
+	return 0
+}
+
+func (x *PreCondNode) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PreCondNode) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreCondNode) GetChildren() []*PreCondNode {
+	if x != nil {
+		return x.Children
 	}
 	return nil
 }
 
-// 签到奖励
-type SignInReward struct {
+type ConditionGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignInReward []*ItemData `protobuf:"bytes,1,rep,name=SignInReward,proto3" json:"SignInReward,omitempty"` // 签到奖励
+	PreCondition []*Condition `protobuf:"bytes,1,rep,name=PreCondition,proto3" json:"PreCondition,omitempty"` // 活动前置条件
 }
 
-func (x *SignInReward) Reset() {
-	*x = SignInReward{}
+func (x *ConditionGroup) Reset() {
+	*x = ConditionGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,13 +1543,13 @@ func (x *SignInReward) Reset() {
 	}
 }
 
-func (x *SignInReward) String() string {
+func (x *ConditionGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInReward) ProtoMessage() {}
+func (*ConditionGroup) ProtoMessage() {}
 
-func (x *SignInReward) ProtoReflect() protoreflect.Message {
+func (x *ConditionGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,35 +1561,29 @@ func (x *SignInReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInReward.ProtoReflect.Descriptor instead.
-func (*SignInReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConditionGroup.ProtoReflect.Descriptor instead.
+func (*ConditionGroup) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SignInReward) GetSignInReward() []*ItemData {
+func (x *ConditionGroup) GetPreCondition() []*Condition {
 	if x != nil {
-		return x.SignInReward
+		return x.PreCondition
 	}
 	return nil
 }
 
-// 签到模板数据
-type SignInTemplate struct {
+// 活动列表
+type ActivityList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerCondition          bool                `protobuf:"varint,1,opt,name=TriggerCondition,proto3" json:"TriggerCondition,omitempty"`                   // 0被动（登陆）触发 1主动（按钮）触发
-	SignInCount               int32               `protobuf:"varint,2,opt,name=SignInCount,proto3" json:"SignInCount,omitempty"`                             // 签到天数
-	RepairSignInCount         int32               `protobuf:"varint,3,opt,name=RepairSignInCount,proto3" json:"RepairSignInCount,omitempty"`                 // 补签次数
-	RepairSignIn              []*RepairSignInRule `protobuf:"bytes,4,rep,name=RepairSignIn,proto3" json:"RepairSignIn,omitempty"`                            // 补签规则(长度等于补签次数)
-	RewardList                []*SignInReward     `protobuf:"bytes,5,rep,name=RewardList,proto3" json:"RewardList,omitempty"`                                // 签到奖励(长度等于天数)
-	IsAutoGetReward           bool                `protobuf:"varint,6,opt,name=IsAutoGetReward,proto3" json:"IsAutoGetReward,omitempty"`                     // 是否自动发放签到奖励 true：自动  false：手动领取
-	EveryDayRepairSignInCount int32               `protobuf:"varint,7,opt,name=EveryDayRepairSignInCount,proto3" json:"EveryDayRepairSignInCount,omitempty"` // 每日补签次数上限
+	List []*ActivityTemplate `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"` // 活动模板列表
 }
 
-func (x *SignInTemplate) Reset() {
-	*x = SignInTemplate{}
+func (x *ActivityList) Reset() {
+	*x = ActivityList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,13 +1591,13 @@ func (x *SignInTemplate) Reset() {
 	}
 }
 
-func (x *SignInTemplate) String() string {
+func (x *ActivityList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInTemplate) ProtoMessage() {}
+func (*ActivityList) ProtoMessage() {}
 
-func (x *SignInTemplate) ProtoReflect() protoreflect.Message {
+func (x *ActivityList) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -993,71 +1609,42 @@ func (x *SignInTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInTemplate.ProtoReflect.Descriptor instead.
-func (*SignInTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityList.ProtoReflect.Descriptor instead.
+func (*ActivityList) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SignInTemplate) GetTriggerCondition() bool {
-	if x != nil {
-		return x.TriggerCondition
-	}
-	return false
-}
-
-func (x *SignInTemplate) GetSignInCount() int32 {
-	if x != nil {
-		return x.SignInCount
-	}
-	return 0
-}
-
-func (x *SignInTemplate) GetRepairSignInCount() int32 {
-	if x != nil {
-		return x.RepairSignInCount
-	}
-	return 0
-}
-
-func (x *SignInTemplate) GetRepairSignIn() []*RepairSignInRule {
-	if x != nil {
-		return x.RepairSignIn
-	}
-	return nil
-}
-
-func (x *SignInTemplate) GetRewardList() []*SignInReward {
+func (x *ActivityList) GetList() []*ActivityTemplate {
 	if x != nil {
-		return x.RewardList
+		return x.List
 	}
 	return nil
 }
 
-func (x *SignInTemplate) GetIsAutoGetReward() bool {
-	if x != nil {
-		return x.IsAutoGetReward
-	}
-	return false
-}
-
-func (x *SignInTemplate) GetEveryDayRepairSignInCount() int32 {
-	if x != nil {
-		return x.EveryDayRepairSignInCount
-	}
-	return 0
-}
-
-// 条件模板数据
-type ConditionTemplate struct {
+// 活动模板数据
+type ActivityTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Condition `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Id           int64                `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`                                                    // 模板ID
+	Name         string               `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`                                                 // 模板名称
+	TemplateType ActivityTemplateType `protobuf:"varint,3,opt,name=TemplateType,proto3,enum=Game.ActivityTemplateType" json:"TemplateType,omitempty"` // 模板类型
+	SignIn       *SignInTemplate      `protobuf:"bytes,4,opt,name=SignIn,proto3" json:"SignIn,omitempty"`                                             // 签到
+	Condition    *ConditionTemplate   `protobuf:"bytes,5,opt,name=Condition,proto3" json:"Condition,omitempty"`                                       // 条件
+	Consumption  *ConsumptionTemplate `protobuf:"bytes,6,opt,name=Consumption,proto3" json:"Consumption,omitempty"`                                   // 消耗
+	Lottery      *LotteryTemplate     `protobuf:"bytes,7,opt,name=Lottery,proto3" json:"Lottery,omitempty"`                                           // 抽奖
+	Extension    *anypb.Any           `protobuf:"bytes,8,opt,name=Extension,proto3" json:"Extension,omitempty"`                                       // 自定义模板配置
+	Ranking      *RankingTemplate     `protobuf:"bytes,9,opt,name=Ranking,proto3" json:"Ranking,omitempty"`                                           // 排行
+	Guild        *GuildTemplate       `protobuf:"bytes,10,opt,name=Guild,proto3" json:"Guild,omitempty"`                                              // 公会
+	Community    *CommunityTemplate   `protobuf:"bytes,11,opt,name=Community,proto3" json:"Community,omitempty"`                                      // 全服目标
+	BattlePass   *BattlePassTemplate  `protobuf:"bytes,12,opt,name=BattlePass,proto3" json:"BattlePass,omitempty"`                                    // 通行证
+	Recharge     *RechargeTemplate    `protobuf:"bytes,13,opt,name=Recharge,proto3" json:"Recharge,omitempty"`                                        // 充值
+	Chain        *ChainTemplate       `protobuf:"bytes,14,opt,name=Chain,proto3" json:"Chain,omitempty"`                                              // 任务链
 }
 
-func (x *ConditionTemplate) Reset() {
-	*x = ConditionTemplate{}
+func (x *ActivityTemplate) Reset() {
+	*x = ActivityTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,13 +1652,13 @@ func (x *ConditionTemplate) Reset() {
 	}
 }
 
-func (x *ConditionTemplate) String() string {
+func (x *ActivityTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConditionTemplate) ProtoMessage() {}
+func (*ActivityTemplate) ProtoMessage() {}
 
-func (x *ConditionTemplate) ProtoReflect() protoreflect.Message {
+func (x *ActivityTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,33 +1670,124 @@ func (x *ConditionTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConditionTemplate.ProtoReflect.Descriptor instead.
-func (*ConditionTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityTemplate.ProtoReflect.Descriptor instead.
+func (*ActivityTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ConditionTemplate) GetData() []*Condition {
+func (x *ActivityTemplate) GetId() int64 {
 	if x != nil {
-		return x.Data
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivityTemplate) GetTemplateType() ActivityTemplateType {
+	if x != nil {
+		return x.TemplateType
+	}
+	return ActivityTemplateType_ATP_INVALID
+}
+
+func (x *ActivityTemplate) GetSignIn() *SignInTemplate {
+	if x != nil {
+		return x.SignIn
 	}
 	return nil
 }
 
-// 商品（消耗）兑换
-type ExchangeGoods struct {
+func (x *ActivityTemplate) GetCondition() *ConditionTemplate {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetConsumption() *ConsumptionTemplate {
+	if x != nil {
+		return x.Consumption
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetLottery() *LotteryTemplate {
+	if x != nil {
+		return x.Lottery
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetExtension() *anypb.Any {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetRanking() *RankingTemplate {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetGuild() *GuildTemplate {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetCommunity() *CommunityTemplate {
+	if x != nil {
+		return x.Community
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetBattlePass() *BattlePassTemplate {
+	if x != nil {
+		return x.BattlePass
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetRecharge() *RechargeTemplate {
+	if x != nil {
+		return x.Recharge
+	}
+	return nil
+}
+
+func (x *ActivityTemplate) GetChain() *ChainTemplate {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+// 条件结构(任务配置数据)
+type Condition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Goods      []*ItemData `protobuf:"bytes,1,rep,name=Goods,proto3" json:"Goods,omitempty"`            // 商品数据
-	IsLimit    bool        `protobuf:"varint,2,opt,name=IsLimit,proto3" json:"IsLimit,omitempty"`       // 是否限购
-	LimitCount int32       `protobuf:"varint,3,opt,name=LimitCount,proto3" json:"LimitCount,omitempty"` // 限购次数
-	Expend     []*ItemData `protobuf:"bytes,4,rep,name=Expend,proto3" json:"Expend,omitempty"`          // 消耗道具信息
-	Discount   int32       `protobuf:"varint,5,opt,name=Discount,proto3" json:"Discount,omitempty"`     // 折扣
+	Condition   int32           `protobuf:"varint,1,opt,name=Condition,proto3" json:"Condition,omitempty"`                               // 条件类型
+	ParamsList  []int32         `protobuf:"varint,2,rep,packed,name=ParamsList,proto3" json:"ParamsList,omitempty"`                      // 条件参数
+	Description string          `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`                            // 条件说明
+	RewardList  []*ItemData     `protobuf:"bytes,4,rep,name=RewardList,proto3" json:"RewardList,omitempty"`                              // 奖励列表
+	RefreshType TaskRefreshType `protobuf:"varint,5,opt,name=RefreshType,proto3,enum=Game.TaskRefreshType" json:"RefreshType,omitempty"` // 刷新类型
 }
 
-func (x *ExchangeGoods) Reset() {
-	*x = ExchangeGoods{}
+func (x *Condition) Reset() {
+	*x = Condition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1117,13 +1795,13 @@ func (x *ExchangeGoods) Reset() {
 	}
 }
 
-func (x *ExchangeGoods) String() string {
+func (x *Condition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeGoods) ProtoMessage() {}
+func (*Condition) ProtoMessage() {}
 
-func (x *ExchangeGoods) ProtoReflect() protoreflect.Message {
+func (x *Condition) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1135,57 +1813,58 @@ func (x *ExchangeGoods) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeGoods.ProtoReflect.Descriptor instead.
-func (*ExchangeGoods) Descriptor() ([]byte, []int) {
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExchangeGoods) GetGoods() []*ItemData {
+func (x *Condition) GetCondition() int32 {
 	if x != nil {
-		return x.Goods
+		return x.Condition
 	}
-	return nil
+	return 0
 }
 
-func (x *ExchangeGoods) GetIsLimit() bool {
+func (x *Condition) GetParamsList() []int32 {
 	if x != nil {
-		return x.IsLimit
+		return x.ParamsList
 	}
-	return false
+	return nil
 }
 
-func (x *ExchangeGoods) GetLimitCount() int32 {
+func (x *Condition) GetDescription() string {
 	if x != nil {
-		return x.LimitCount
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *ExchangeGoods) GetExpend() []*ItemData {
+func (x *Condition) GetRewardList() []*ItemData {
 	if x != nil {
-		return x.Expend
+		return x.RewardList
 	}
 	return nil
 }
 
-func (x *ExchangeGoods) GetDiscount() int32 {
+func (x *Condition) GetRefreshType() TaskRefreshType {
 	if x != nil {
-		return x.Discount
+		return x.RefreshType
 	}
-	return 0
+	return TaskRefreshType_TRT_NOT
 }
 
-// 消费模板
-type ConsumptionTemplate struct {
+// 补签规则
+type RepairSignInRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SellGoods []*ExchangeGoods `protobuf:"bytes,1,rep,name=SellGoods,proto3" json:"SellGoods,omitempty"`
+	RSI_Expend    []*ItemData  `protobuf:"bytes,1,rep,name=RSI_Expend,json=RSIExpend,proto3" json:"RSI_Expend,omitempty"`          // 道具消耗补签
+	RSI_Condition []*Condition `protobuf:"bytes,2,rep,name=RSI_Condition,json=RSICondition,proto3" json:"RSI_Condition,omitempty"` // 条件补签
 }
 
-func (x *ConsumptionTemplate) Reset() {
-	*x = ConsumptionTemplate{}
+func (x *RepairSignInRule) Reset() {
+	*x = RepairSignInRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1193,13 +1872,13 @@ func (x *ConsumptionTemplate) Reset() {
 	}
 }
 
-func (x *ConsumptionTemplate) String() string {
+func (x *RepairSignInRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumptionTemplate) ProtoMessage() {}
+func (*RepairSignInRule) ProtoMessage() {}
 
-func (x *ConsumptionTemplate) ProtoReflect() protoreflect.Message {
+func (x *RepairSignInRule) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1211,33 +1890,36 @@ func (x *ConsumptionTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumptionTemplate.ProtoReflect.Descriptor instead.
-func (*ConsumptionTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepairSignInRule.ProtoReflect.Descriptor instead.
+func (*RepairSignInRule) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ConsumptionTemplate) GetSellGoods() []*ExchangeGoods {
+func (x *RepairSignInRule) GetRSI_Expend() []*ItemData {
 	if x != nil {
-		return x.SellGoods
+		return x.RSI_Expend
 	}
 	return nil
 }
 
-// 抽奖
-type Lottery struct {
+func (x *RepairSignInRule) GetRSI_Condition() []*Condition {
+	if x != nil {
+		return x.RSI_Condition
+	}
+	return nil
+}
+
+// 签到奖励
+type SignInReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SingleCost          int32 `protobuf:"varint,1,opt,name=SingleCost,proto3" json:"SingleCost,omitempty"`                   // 单抽消耗
-	FirstSingleDiscount int32 `protobuf:"varint,2,opt,name=FirstSingleDiscount,proto3" json:"FirstSingleDiscount,omitempty"` // 单抽首次折扣
-	MultiCount          int32 `protobuf:"varint,3,opt,name=MultiCount,proto3" json:"MultiCount,omitempty"`                   // 多抽次数
-	FirstMultiDiscount  int32 `protobuf:"varint,4,opt,name=FirstMultiDiscount,proto3" json:"FirstMultiDiscount,omitempty"`   // 多抽首次折扣
-	MultiDiscount       int32 `protobuf:"varint,5,opt,name=MultiDiscount,proto3" json:"MultiDiscount,omitempty"`             // 多抽折扣
+	SignInReward []*ItemData `protobuf:"bytes,1,rep,name=SignInReward,proto3" json:"SignInReward,omitempty"` // 签到奖励
 }
 
-func (x *Lottery) Reset() {
-	*x = Lottery{}
+func (x *SignInReward) Reset() {
+	*x = SignInReward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,13 +1927,13 @@ func (x *Lottery) Reset() {
 	}
 }
 
-func (x *Lottery) String() string {
+func (x *SignInReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lottery) ProtoMessage() {}
+func (*SignInReward) ProtoMessage() {}
 
-func (x *Lottery) ProtoReflect() protoreflect.Message {
+func (x *SignInReward) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1263,62 +1945,40 @@ func (x *Lottery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lottery.ProtoReflect.Descriptor instead.
-func (*Lottery) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignInReward.ProtoReflect.Descriptor instead.
+func (*SignInReward) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Lottery) GetSingleCost() int32 {
-	if x != nil {
-		return x.SingleCost
-	}
-	return 0
-}
-
-func (x *Lottery) GetFirstSingleDiscount() int32 {
-	if x != nil {
-		return x.FirstSingleDiscount
-	}
-	return 0
-}
-
-func (x *Lottery) GetMultiCount() int32 {
-	if x != nil {
-		return x.MultiCount
-	}
-	return 0
-}
-
-func (x *Lottery) GetFirstMultiDiscount() int32 {
-	if x != nil {
-		return x.FirstMultiDiscount
-	}
-	return 0
-}
-
-func (x *Lottery) GetMultiDiscount() int32 {
+func (x *SignInReward) GetSignInReward() []*ItemData {
 	if x != nil {
-		return x.MultiDiscount
+		return x.SignInReward
 	}
-	return 0
+	return nil
 }
 
-// 抽奖模板
-type LotteryTemplate struct {
+// 签到模板数据
+type SignInTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TargetGoods     *ItemData  `protobuf:"bytes,1,opt,name=TargetGoods,proto3" json:"TargetGoods,omitempty"`          // 包装道具
-	CostItemId      int32      `protobuf:"varint,2,opt,name=CostItemId,proto3" json:"CostItemId,omitempty"`           // 抽奖消耗道具ID
-	LotteryList     []*Lottery `protobuf:"bytes,3,rep,name=LotteryList,proto3" json:"LotteryList,omitempty"`          // 抽奖列表
-	GuaranteedCount int32      `protobuf:"varint,4,opt,name=GuaranteedCount,proto3" json:"GuaranteedCount,omitempty"` // 保底次数（共享）
-	GuaranteedItem  *ItemData  `protobuf:"bytes,5,opt,name=GuaranteedItem,proto3" json:"GuaranteedItem,omitempty"`    // 保底道具
-	RewardPoolId    int32      `protobuf:"varint,6,opt,name=RewardPoolId,proto3" json:"RewardPoolId,omitempty"`       // 奖池ID
+	TriggerCondition          bool                `protobuf:"varint,1,opt,name=TriggerCondition,proto3" json:"TriggerCondition,omitempty"`                   // 0被动（登陆）触发 1主动（按钮）触发
+	SignInCount               int32               `protobuf:"varint,2,opt,name=SignInCount,proto3" json:"SignInCount,omitempty"`                             // 签到天数
+	RepairSignInCount         int32               `protobuf:"varint,3,opt,name=RepairSignInCount,proto3" json:"RepairSignInCount,omitempty"`                 // 补签次数
+	RepairSignIn              []*RepairSignInRule `protobuf:"bytes,4,rep,name=RepairSignIn,proto3" json:"RepairSignIn,omitempty"`                            // 补签规则(长度等于补签次数)
+	RewardList                []*SignInReward     `protobuf:"bytes,5,rep,name=RewardList,proto3" json:"RewardList,omitempty"`                                // 签到奖励(长度等于天数),月历签到按当月日期(1-31)对应
+	IsAutoGetReward           bool                `protobuf:"varint,6,opt,name=IsAutoGetReward,proto3" json:"IsAutoGetReward,omitempty"`                     // 是否自动发放签到奖励 true：自动  false：手动领取
+	EveryDayRepairSignInCount int32               `protobuf:"varint,7,opt,name=EveryDayRepairSignInCount,proto3" json:"EveryDayRepairSignInCount,omitempty"` // 每日补签次数上限
+	Mode                      SignInMode          `protobuf:"varint,8,opt,name=Mode,proto3,enum=Game.SignInMode" json:"Mode,omitempty"`                      // 签到模式
+	StreakGraceDays           int32               `protobuf:"varint,9,opt,name=StreakGraceDays,proto3" json:"StreakGraceDays,omitempty"`                     // 连续签到:断签宽限天数,断签天数不超过宽限天数时连续天数不中断
+	StreakDecay               int32               `protobuf:"varint,10,opt,name=StreakDecay,proto3" json:"StreakDecay,omitempty"`                            // 连续签到:每断签一天扣减的连续天数,0表示断签清零
+	StreakFreezeItemId        int32               `protobuf:"varint,11,opt,name=StreakFreezeItemId,proto3" json:"StreakFreezeItemId,omitempty"`              // 连续签到:断签保护道具Id,每断签一天消耗一个,道具不足时按持有数量保护,断签清零时需保护全部断签天数,0表示不启用
+	StreakCycle               bool                `protobuf:"varint,12,opt,name=StreakCycle,proto3" json:"StreakCycle,omitempty"`                            // 连续签到:连续天数超过奖励列表长度后是否循环发放
 }
 
-func (x *LotteryTemplate) Reset() {
-	*x = LotteryTemplate{}
+func (x *SignInTemplate) Reset() {
+	*x = SignInTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1326,13 +1986,13 @@ func (x *LotteryTemplate) Reset() {
 	}
 }
 
-func (x *LotteryTemplate) String() string {
+func (x *SignInTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LotteryTemplate) ProtoMessage() {}
+func (*SignInTemplate) ProtoMessage() {}
 
-func (x *LotteryTemplate) ProtoReflect() protoreflect.Message {
+func (x *SignInTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1344,65 +2004,106 @@ func (x *LotteryTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LotteryTemplate.ProtoReflect.Descriptor instead.
-func (*LotteryTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignInTemplate.ProtoReflect.Descriptor instead.
+func (*SignInTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LotteryTemplate) GetTargetGoods() *ItemData {
+func (x *SignInTemplate) GetTriggerCondition() bool {
 	if x != nil {
-		return x.TargetGoods
+		return x.TriggerCondition
 	}
-	return nil
+	return false
 }
 
-func (x *LotteryTemplate) GetCostItemId() int32 {
+func (x *SignInTemplate) GetSignInCount() int32 {
 	if x != nil {
-		return x.CostItemId
+		return x.SignInCount
 	}
 	return 0
 }
 
-func (x *LotteryTemplate) GetLotteryList() []*Lottery {
+func (x *SignInTemplate) GetRepairSignInCount() int32 {
 	if x != nil {
-		return x.LotteryList
+		return x.RepairSignInCount
+	}
+	return 0
+}
+
+func (x *SignInTemplate) GetRepairSignIn() []*RepairSignInRule {
+	if x != nil {
+		return x.RepairSignIn
 	}
 	return nil
 }
 
-func (x *LotteryTemplate) GetGuaranteedCount() int32 {
+func (x *SignInTemplate) GetRewardList() []*SignInReward {
 	if x != nil {
-		return x.GuaranteedCount
+		return x.RewardList
+	}
+	return nil
+}
+
+func (x *SignInTemplate) GetIsAutoGetReward() bool {
+	if x != nil {
+		return x.IsAutoGetReward
+	}
+	return false
+}
+
+func (x *SignInTemplate) GetEveryDayRepairSignInCount() int32 {
+	if x != nil {
+		return x.EveryDayRepairSignInCount
 	}
 	return 0
 }
 
-func (x *LotteryTemplate) GetGuaranteedItem() *ItemData {
+func (x *SignInTemplate) GetMode() SignInMode {
 	if x != nil {
-		return x.GuaranteedItem
+		return x.Mode
 	}
-	return nil
+	return SignInMode_SIM_CUMULATIVE
 }
 
-func (x *LotteryTemplate) GetRewardPoolId() int32 {
+func (x *SignInTemplate) GetStreakGraceDays() int32 {
 	if x != nil {
-		return x.RewardPoolId
+		return x.StreakGraceDays
 	}
 	return 0
 }
 
-// 奖池
-type RewardPool struct {
+func (x *SignInTemplate) GetStreakDecay() int32 {
+	if x != nil {
+		return x.StreakDecay
+	}
+	return 0
+}
+
+func (x *SignInTemplate) GetStreakFreezeItemId() int32 {
+	if x != nil {
+		return x.StreakFreezeItemId
+	}
+	return 0
+}
+
+func (x *SignInTemplate) GetStreakCycle() bool {
+	if x != nil {
+		return x.StreakCycle
+	}
+	return false
+}
+
+// 条件模板数据
+type ConditionTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reward      *ItemData `protobuf:"bytes,1,opt,name=Reward,proto3" json:"Reward,omitempty"`
-	WeightValue int32     `protobuf:"varint,2,opt,name=WeightValue,proto3" json:"WeightValue,omitempty"`
+	Data []*Condition `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *RewardPool) Reset() {
-	*x = RewardPool{}
+func (x *ConditionTemplate) Reset() {
+	*x = ConditionTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,13 +2111,13 @@ func (x *RewardPool) Reset() {
 	}
 }
 
-func (x *RewardPool) String() string {
+func (x *ConditionTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardPool) ProtoMessage() {}
+func (*ConditionTemplate) ProtoMessage() {}
 
-func (x *RewardPool) ProtoReflect() protoreflect.Message {
+func (x *ConditionTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1428,37 +2129,37 @@ func (x *RewardPool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardPool.ProtoReflect.Descriptor instead.
-func (*RewardPool) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConditionTemplate.ProtoReflect.Descriptor instead.
+func (*ConditionTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RewardPool) GetReward() *ItemData {
+func (x *ConditionTemplate) GetData() []*Condition {
 	if x != nil {
-		return x.Reward
+		return x.Data
 	}
 	return nil
 }
 
-func (x *RewardPool) GetWeightValue() int32 {
-	if x != nil {
-		return x.WeightValue
-	}
-	return 0
-}
-
-// 积分模板
-type ScoreTemplate struct {
+// 商品（消耗）兑换
+type ExchangeGoods struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Score  *ItemData   `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"`   // 积分道具
-	Reward []*ItemData `protobuf:"bytes,2,rep,name=Reward,proto3" json:"Reward,omitempty"` // 对应积分奖励
+	Goods       []*ItemData     `protobuf:"bytes,1,rep,name=Goods,proto3" json:"Goods,omitempty"`                                        // 商品数据
+	IsLimit     bool            `protobuf:"varint,2,opt,name=IsLimit,proto3" json:"IsLimit,omitempty"`                                   // 是否限购
+	LimitCount  int32           `protobuf:"varint,3,opt,name=LimitCount,proto3" json:"LimitCount,omitempty"`                             // 限购次数
+	Expend      []*ItemData     `protobuf:"bytes,4,rep,name=Expend,proto3" json:"Expend,omitempty"`                                      // 消耗道具信息
+	Discount    int32           `protobuf:"varint,5,opt,name=Discount,proto3" json:"Discount,omitempty"`                                 // 折扣(百分比,80表示8折,0或>=100不打折)
+	PriceSteps  []int32         `protobuf:"varint,6,rep,packed,name=PriceSteps,proto3" json:"PriceSteps,omitempty"`                      // 阶梯价格(百分比),第N次购买使用第N个值,超出使用最后一个,为空不加价
+	SaleWindows []*SaleWindow   `protobuf:"bytes,7,rep,name=SaleWindows,proto3" json:"SaleWindows,omitempty"`                            // 限时折扣
+	RefreshType TaskRefreshType `protobuf:"varint,8,opt,name=RefreshType,proto3,enum=Game.TaskRefreshType" json:"RefreshType,omitempty"` // 购买次数刷新类型,刷新时重置限购次数和阶梯价格
+	Stock       int32           `protobuf:"varint,9,opt,name=Stock,proto3" json:"Stock,omitempty"`                                       // 全服库存,0表示不限
 }
 
-func (x *ScoreTemplate) Reset() {
-	*x = ScoreTemplate{}
+func (x *ExchangeGoods) Reset() {
+	*x = ExchangeGoods{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1466,13 +2167,13 @@ func (x *ScoreTemplate) Reset() {
 	}
 }
 
-func (x *ScoreTemplate) String() string {
+func (x *ExchangeGoods) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoreTemplate) ProtoMessage() {}
+func (*ExchangeGoods) ProtoMessage() {}
 
-func (x *ScoreTemplate) ProtoReflect() protoreflect.Message {
+func (x *ExchangeGoods) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1484,39 +2185,87 @@ func (x *ScoreTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoreTemplate.ProtoReflect.Descriptor instead.
-func (*ScoreTemplate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExchangeGoods.ProtoReflect.Descriptor instead.
+func (*ExchangeGoods) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ScoreTemplate) GetScore() *ItemData {
+func (x *ExchangeGoods) GetGoods() []*ItemData {
 	if x != nil {
-		return x.Score
+		return x.Goods
 	}
 	return nil
 }
 
-func (x *ScoreTemplate) GetReward() []*ItemData {
+func (x *ExchangeGoods) GetIsLimit() bool {
 	if x != nil {
-		return x.Reward
+		return x.IsLimit
+	}
+	return false
+}
+
+func (x *ExchangeGoods) GetLimitCount() int32 {
+	if x != nil {
+		return x.LimitCount
+	}
+	return 0
+}
+
+func (x *ExchangeGoods) GetExpend() []*ItemData {
+	if x != nil {
+		return x.Expend
 	}
 	return nil
 }
 
-// 任务数据
-type OperateTaskInfo struct {
+func (x *ExchangeGoods) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ExchangeGoods) GetPriceSteps() []int32 {
+	if x != nil {
+		return x.PriceSteps
+	}
+	return nil
+}
+
+func (x *ExchangeGoods) GetSaleWindows() []*SaleWindow {
+	if x != nil {
+		return x.SaleWindows
+	}
+	return nil
+}
+
+func (x *ExchangeGoods) GetRefreshType() TaskRefreshType {
+	if x != nil {
+		return x.RefreshType
+	}
+	return TaskRefreshType_TRT_NOT
+}
+
+func (x *ExchangeGoods) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+// 限时折扣
+type SaleWindow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId      int32            `protobuf:"varint,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	TaskState   OperateTaskState `protobuf:"varint,2,opt,name=taskState,proto3,enum=Game.OperateTaskState" json:"taskState,omitempty"` // 任务状态 0:进行中, 1:完成未领奖  2：完成已领奖
-	Progress    int32            `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`                              // 任务进度
-	CompleteNum int32            `protobuf:"varint,4,opt,name=completeNum,proto3" json:"completeNum,omitempty"`                        // 完成次数
+	StartTime int64 `protobuf:"varint,1,opt,name=StartTime,proto3" json:"StartTime,omitempty"` // 开始时间(s)
+	EndTime   int64 `protobuf:"varint,2,opt,name=EndTime,proto3" json:"EndTime,omitempty"`     // 结束时间(s)
+	Discount  int32 `protobuf:"varint,3,opt,name=Discount,proto3" json:"Discount,omitempty"`   // 折扣(百分比),生效期间替代ExchangeGoods.Discount
 }
 
-func (x *OperateTaskInfo) Reset() {
-	*x = OperateTaskInfo{}
+func (x *SaleWindow) Reset() {
+	*x = SaleWindow{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1524,13 +2273,13 @@ func (x *OperateTaskInfo) Reset() {
 	}
 }
 
-func (x *OperateTaskInfo) String() string {
+func (x *SaleWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperateTaskInfo) ProtoMessage() {}
+func (*SaleWindow) ProtoMessage() {}
 
-func (x *OperateTaskInfo) ProtoReflect() protoreflect.Message {
+func (x *SaleWindow) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,52 +2291,43 @@ func (x *OperateTaskInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperateTaskInfo.ProtoReflect.Descriptor instead.
-func (*OperateTaskInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaleWindow.ProtoReflect.Descriptor instead.
+func (*SaleWindow) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *OperateTaskInfo) GetTaskId() int32 {
+func (x *SaleWindow) GetStartTime() int64 {
 	if x != nil {
-		return x.TaskId
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *OperateTaskInfo) GetTaskState() OperateTaskState {
-	if x != nil {
-		return x.TaskState
-	}
-	return OperateTaskState_OTS_Doing
-}
-
-func (x *OperateTaskInfo) GetProgress() int32 {
+func (x *SaleWindow) GetEndTime() int64 {
 	if x != nil {
-		return x.Progress
+		return x.EndTime
 	}
 	return 0
 }
 
-func (x *OperateTaskInfo) GetCompleteNum() int32 {
+func (x *SaleWindow) GetDiscount() int32 {
 	if x != nil {
-		return x.CompleteNum
+		return x.Discount
 	}
 	return 0
 }
 
-type OperateActivityDB struct {
+// 消费模板
+type ConsumptionTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId   int64                     `protobuf:"varint,1,opt,name=ActivityId,proto3" json:"ActivityId,omitempty"`                                                                                             // 活动ID
-	PreTaskGroup []*TaskGroup              `protobuf:"bytes,2,rep,name=PreTaskGroup,proto3" json:"PreTaskGroup,omitempty"`                                                                                          // 前置任务进度信息
-	GotScores    map[int32]bool            `protobuf:"bytes,3,rep,name=GotScores,proto3" json:"GotScores,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`      // 已领取积分信息  key:OperateActivity.ScoreTemplate 数组索引  value:是否开启
-	ActivityList map[int32]*ActivityDBList `protobuf:"bytes,4,rep,name=ActivityList,proto3" json:"ActivityList,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 活动模板ID组  key:活动天数  value：活动模板列表
+	SellGoods []*ExchangeGoods `protobuf:"bytes,1,rep,name=SellGoods,proto3" json:"SellGoods,omitempty"`
 }
 
-func (x *OperateActivityDB) Reset() {
-	*x = OperateActivityDB{}
+func (x *ConsumptionTemplate) Reset() {
+	*x = ConsumptionTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1595,13 +2335,13 @@ func (x *OperateActivityDB) Reset() {
 	}
 }
 
-func (x *OperateActivityDB) String() string {
+func (x *ConsumptionTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperateActivityDB) ProtoMessage() {}
+func (*ConsumptionTemplate) ProtoMessage() {}
 
-func (x *OperateActivityDB) ProtoReflect() protoreflect.Message {
+func (x *ConsumptionTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1613,49 +2353,33 @@ func (x *OperateActivityDB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperateActivityDB.ProtoReflect.Descriptor instead.
-func (*OperateActivityDB) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumptionTemplate.ProtoReflect.Descriptor instead.
+func (*ConsumptionTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *OperateActivityDB) GetActivityId() int64 {
-	if x != nil {
-		return x.ActivityId
-	}
-	return 0
-}
-
-func (x *OperateActivityDB) GetPreTaskGroup() []*TaskGroup {
-	if x != nil {
-		return x.PreTaskGroup
-	}
-	return nil
-}
-
-func (x *OperateActivityDB) GetGotScores() map[int32]bool {
-	if x != nil {
-		return x.GotScores
-	}
-	return nil
-}
-
-func (x *OperateActivityDB) GetActivityList() map[int32]*ActivityDBList {
+func (x *ConsumptionTemplate) GetSellGoods() []*ExchangeGoods {
 	if x != nil {
-		return x.ActivityList
+		return x.SellGoods
 	}
 	return nil
 }
 
-type TaskGroup struct {
+//抽奖
+type Lottery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreTaskInfos []*OperateTaskInfo `protobuf:"bytes,1,rep,name=PreTaskInfos,proto3" json:"PreTaskInfos,omitempty"` // 前置任务进度信息
+	SingleCost          int32 `protobuf:"varint,1,opt,name=SingleCost,proto3" json:"SingleCost,omitempty"`                   // 单抽消耗
+	FirstSingleDiscount int32 `protobuf:"varint,2,opt,name=FirstSingleDiscount,proto3" json:"FirstSingleDiscount,omitempty"` // 单抽首次折扣
+	MultiCount          int32 `protobuf:"varint,3,opt,name=MultiCount,proto3" json:"MultiCount,omitempty"`                   // 多抽次数
+	FirstMultiDiscount  int32 `protobuf:"varint,4,opt,name=FirstMultiDiscount,proto3" json:"FirstMultiDiscount,omitempty"`   // 多抽首次折扣
+	MultiDiscount       int32 `protobuf:"varint,5,opt,name=MultiDiscount,proto3" json:"MultiDiscount,omitempty"`             // 多抽折扣
 }
 
-func (x *TaskGroup) Reset() {
-	*x = TaskGroup{}
+func (x *Lottery) Reset() {
+	*x = Lottery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1663,13 +2387,13 @@ func (x *TaskGroup) Reset() {
 	}
 }
 
-func (x *TaskGroup) String() string {
+func (x *Lottery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskGroup) ProtoMessage() {}
+func (*Lottery) ProtoMessage() {}
 
-func (x *TaskGroup) ProtoReflect() protoreflect.Message {
+func (x *Lottery) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,29 +2405,62 @@ func (x *TaskGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskGroup.ProtoReflect.Descriptor instead.
-func (*TaskGroup) Descriptor() ([]byte, []int) {
-	return file_global_operate_activity_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Lottery.ProtoReflect.Descriptor instead.
+func (*Lottery) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TaskGroup) GetPreTaskInfos() []*OperateTaskInfo {
+func (x *Lottery) GetSingleCost() int32 {
 	if x != nil {
-		return x.PreTaskInfos
+		return x.SingleCost
 	}
-	return nil
+	return 0
 }
 
-// 活动列表
-type ActivityDBList struct {
+func (x *Lottery) GetFirstSingleDiscount() int32 {
+	if x != nil {
+		return x.FirstSingleDiscount
+	}
+	return 0
+}
+
+func (x *Lottery) GetMultiCount() int32 {
+	if x != nil {
+		return x.MultiCount
+	}
+	return 0
+}
+
+func (x *Lottery) GetFirstMultiDiscount() int32 {
+	if x != nil {
+		return x.FirstMultiDiscount
+	}
+	return 0
+}
+
+func (x *Lottery) GetMultiDiscount() int32 {
+	if x != nil {
+		return x.MultiDiscount
+	}
+	return 0
+}
+
+// 抽奖模板
+type LotteryTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List map[int32]*ActivityTemplateDB `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 活动模板列表  key：模板索引  value：模板数据
+	TargetGoods     *ItemData  `protobuf:"bytes,1,opt,name=TargetGoods,proto3" json:"TargetGoods,omitempty"`          // 包装道具
+	CostItemId      int32      `protobuf:"varint,2,opt,name=CostItemId,proto3" json:"CostItemId,omitempty"`           // 抽奖消耗道具ID
+	LotteryList     []*Lottery `protobuf:"bytes,3,rep,name=LotteryList,proto3" json:"LotteryList,omitempty"`          // 抽奖列表
+	GuaranteedCount int32      `protobuf:"varint,4,opt,name=GuaranteedCount,proto3" json:"GuaranteedCount,omitempty"` // 保底次数（共享）
+	GuaranteedItem  *ItemData  `protobuf:"bytes,5,opt,name=GuaranteedItem,proto3" json:"GuaranteedItem,omitempty"`    // 保底道具
+	RewardPoolId    int32      `protobuf:"varint,6,opt,name=RewardPoolId,proto3" json:"RewardPoolId,omitempty"`       // 奖池ID
 }
 
-func (x *ActivityDBList) Reset() {
-	*x = ActivityDBList{}
+func (x *LotteryTemplate) Reset() {
+	*x = LotteryTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1711,13 +2468,13 @@ func (x *ActivityDBList) Reset() {
 	}
 }
 
-func (x *ActivityDBList) String() string {
+func (x *LotteryTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityDBList) ProtoMessage() {}
+func (*LotteryTemplate) ProtoMessage() {}
 
-func (x *ActivityDBList) ProtoReflect() protoreflect.Message {
+func (x *LotteryTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1729,30 +2486,65 @@ func (x *ActivityDBList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityDBList.ProtoReflect.Descriptor instead.
-func (*ActivityDBList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LotteryTemplate.ProtoReflect.Descriptor instead.
+func (*LotteryTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ActivityDBList) GetList() map[int32]*ActivityTemplateDB {
+func (x *LotteryTemplate) GetTargetGoods() *ItemData {
 	if x != nil {
-		return x.List
+		return x.TargetGoods
 	}
 	return nil
 }
 
-type ActivityTemplateDB struct {
+func (x *LotteryTemplate) GetCostItemId() int32 {
+	if x != nil {
+		return x.CostItemId
+	}
+	return 0
+}
+
+func (x *LotteryTemplate) GetLotteryList() []*Lottery {
+	if x != nil {
+		return x.LotteryList
+	}
+	return nil
+}
+
+func (x *LotteryTemplate) GetGuaranteedCount() int32 {
+	if x != nil {
+		return x.GuaranteedCount
+	}
+	return 0
+}
+
+func (x *LotteryTemplate) GetGuaranteedItem() *ItemData {
+	if x != nil {
+		return x.GuaranteedItem
+	}
+	return nil
+}
+
+func (x *LotteryTemplate) GetRewardPoolId() int32 {
+	if x != nil {
+		return x.RewardPoolId
+	}
+	return 0
+}
+
+// 排行模板
+type RankingTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignInDB      *SignInTemplateDB      `protobuf:"bytes,1,opt,name=SignInDB,proto3" json:"SignInDB,omitempty"`
-	ConsumptionDB *ConsumptionTemplateDB `protobuf:"bytes,2,opt,name=ConsumptionDB,proto3" json:"ConsumptionDB,omitempty"`
-	ConditionDB   *ConditionTemplateDB   `protobuf:"bytes,3,opt,name=ConditionDB,proto3" json:"ConditionDB,omitempty"`
+	RewardList []*RankReward `protobuf:"bytes,1,rep,name=RewardList,proto3" json:"RewardList,omitempty"` // 排名奖励,活动结束时邮件发放
+	MinScore   int64         `protobuf:"varint,2,opt,name=MinScore,proto3" json:"MinScore,omitempty"`    // 上榜最低积分
 }
 
-func (x *ActivityTemplateDB) Reset() {
-	*x = ActivityTemplateDB{}
+func (x *RankingTemplate) Reset() {
+	*x = RankingTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1760,13 +2552,13 @@ func (x *ActivityTemplateDB) Reset() {
 	}
 }
 
-func (x *ActivityTemplateDB) String() string {
+func (x *RankingTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityTemplateDB) ProtoMessage() {}
+func (*RankingTemplate) ProtoMessage() {}
 
-func (x *ActivityTemplateDB) ProtoReflect() protoreflect.Message {
+func (x *RankingTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1778,42 +2570,36 @@ func (x *ActivityTemplateDB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityTemplateDB.ProtoReflect.Descriptor instead.
-func (*ActivityTemplateDB) Descriptor() ([]byte, []int) {
+// Deprecated: Use RankingTemplate.ProtoReflect.Descriptor instead.
+func (*RankingTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ActivityTemplateDB) GetSignInDB() *SignInTemplateDB {
-	if x != nil {
-		return x.SignInDB
-	}
-	return nil
-}
-
-func (x *ActivityTemplateDB) GetConsumptionDB() *ConsumptionTemplateDB {
+func (x *RankingTemplate) GetRewardList() []*RankReward {
 	if x != nil {
-		return x.ConsumptionDB
+		return x.RewardList
 	}
 	return nil
 }
 
-func (x *ActivityTemplateDB) GetConditionDB() *ConditionTemplateDB {
+func (x *RankingTemplate) GetMinScore() int64 {
 	if x != nil {
-		return x.ConditionDB
+		return x.MinScore
 	}
-	return nil
+	return 0
 }
 
-type ConsumptionTemplateDB struct {
+// 公会模板,任务进度为公会成员贡献之和
+type GuildTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuyCounts map[int32]int32 `protobuf:"bytes,1,rep,name=BuyCounts,proto3" json:"BuyCounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已购买次数
+	Tasks []*GuildTask `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"` // 公会任务
 }
 
-func (x *ConsumptionTemplateDB) Reset() {
-	*x = ConsumptionTemplateDB{}
+func (x *GuildTemplate) Reset() {
+	*x = GuildTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1821,13 +2607,13 @@ func (x *ConsumptionTemplateDB) Reset() {
 	}
 }
 
-func (x *ConsumptionTemplateDB) String() string {
+func (x *GuildTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumptionTemplateDB) ProtoMessage() {}
+func (*GuildTemplate) ProtoMessage() {}
 
-func (x *ConsumptionTemplateDB) ProtoReflect() protoreflect.Message {
+func (x *GuildTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1839,33 +2625,31 @@ func (x *ConsumptionTemplateDB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumptionTemplateDB.ProtoReflect.Descriptor instead.
-func (*ConsumptionTemplateDB) Descriptor() ([]byte, []int) {
+// Deprecated: Use GuildTemplate.ProtoReflect.Descriptor instead.
+func (*GuildTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ConsumptionTemplateDB) GetBuyCounts() map[int32]int32 {
+func (x *GuildTemplate) GetTasks() []*GuildTask {
 	if x != nil {
-		return x.BuyCounts
+		return x.Tasks
 	}
 	return nil
 }
 
-type SignInTemplateDB struct {
+// 公会任务
+type GuildTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignedDay           int32              `protobuf:"varint,1,opt,name=SignedDay,proto3" json:"SignedDay,omitempty"`                                                                                // 已签到天数
-	LastSignTimestamp   int64              `protobuf:"varint,2,opt,name=LastSignTimestamp,proto3" json:"LastSignTimestamp,omitempty"`                                                                // 上次签到时间戳
-	Conditions          []*RepairCondition `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`                                                                               // 补签任务进度
-	RepairCount         int32              `protobuf:"varint,4,opt,name=RepairCount,proto3" json:"RepairCount,omitempty"`                                                                            // 补签次数
-	Gots                map[int32]bool     `protobuf:"bytes,5,rep,name=Gots,proto3" json:"Gots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领取奖励
-	EveryDayRepairCount int32              `protobuf:"varint,6,opt,name=EveryDayRepairCount,proto3" json:"EveryDayRepairCount,omitempty"`                                                            // 每日已补签次数
+	Task            *Condition `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`                        // 任务条件和奖励
+	Target          int64      `protobuf:"varint,2,opt,name=Target,proto3" json:"Target,omitempty"`                   // 公会总进度目标
+	MinContribution int64      `protobuf:"varint,3,opt,name=MinContribution,proto3" json:"MinContribution,omitempty"` // 领奖需要的个人在当前公会的最低贡献
 }
 
-func (x *SignInTemplateDB) Reset() {
-	*x = SignInTemplateDB{}
+func (x *GuildTask) Reset() {
+	*x = GuildTask{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,13 +2657,13 @@ func (x *SignInTemplateDB) Reset() {
 	}
 }
 
-func (x *SignInTemplateDB) String() string {
+func (x *GuildTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInTemplateDB) ProtoMessage() {}
+func (*GuildTask) ProtoMessage() {}
 
-func (x *SignInTemplateDB) ProtoReflect() protoreflect.Message {
+func (x *GuildTask) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,63 +2675,45 @@ func (x *SignInTemplateDB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInTemplateDB.ProtoReflect.Descriptor instead.
-func (*SignInTemplateDB) Descriptor() ([]byte, []int) {
+// Deprecated: Use GuildTask.ProtoReflect.Descriptor instead.
+func (*GuildTask) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SignInTemplateDB) GetSignedDay() int32 {
-	if x != nil {
-		return x.SignedDay
-	}
-	return 0
-}
-
-func (x *SignInTemplateDB) GetLastSignTimestamp() int64 {
-	if x != nil {
-		return x.LastSignTimestamp
-	}
-	return 0
-}
-
-func (x *SignInTemplateDB) GetConditions() []*RepairCondition {
+func (x *GuildTask) GetTask() *Condition {
 	if x != nil {
-		return x.Conditions
+		return x.Task
 	}
 	return nil
 }
 
-func (x *SignInTemplateDB) GetRepairCount() int32 {
+func (x *GuildTask) GetTarget() int64 {
 	if x != nil {
-		return x.RepairCount
+		return x.Target
 	}
 	return 0
 }
 
-func (x *SignInTemplateDB) GetGots() map[int32]bool {
-	if x != nil {
-		return x.Gots
-	}
-	return nil
-}
-
-func (x *SignInTemplateDB) GetEveryDayRepairCount() int32 {
+func (x *GuildTask) GetMinContribution() int64 {
 	if x != nil {
-		return x.EveryDayRepairCount
+		return x.MinContribution
 	}
 	return 0
 }
 
-type RepairCondition struct {
+// 全服目标模板,全服进度按活动和区服累计
+type CommunityTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tasks []*OperateTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // 补签任务进度
+	Task            *Condition            `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`                        // 累计进度的任务条件,奖励不生效
+	Milestones      []*CommunityMilestone `protobuf:"bytes,2,rep,name=Milestones,proto3" json:"Milestones,omitempty"`            // 目标档位
+	MinContribution int64                 `protobuf:"varint,3,opt,name=MinContribution,proto3" json:"MinContribution,omitempty"` // 领奖需要的个人最低贡献,0:所有玩家可领
 }
 
-func (x *RepairCondition) Reset() {
-	*x = RepairCondition{}
+func (x *CommunityTemplate) Reset() {
+	*x = CommunityTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1955,13 +2721,13 @@ func (x *RepairCondition) Reset() {
 	}
 }
 
-func (x *RepairCondition) String() string {
+func (x *CommunityTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepairCondition) ProtoMessage() {}
+func (*CommunityTemplate) ProtoMessage() {}
 
-func (x *RepairCondition) ProtoReflect() protoreflect.Message {
+func (x *CommunityTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1973,28 +2739,44 @@ func (x *RepairCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepairCondition.ProtoReflect.Descriptor instead.
-func (*RepairCondition) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommunityTemplate.ProtoReflect.Descriptor instead.
+func (*CommunityTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RepairCondition) GetTasks() []*OperateTaskInfo {
+func (x *CommunityTemplate) GetTask() *Condition {
 	if x != nil {
-		return x.Tasks
+		return x.Task
 	}
 	return nil
 }
 
-type ConditionTemplateDB struct {
+func (x *CommunityTemplate) GetMilestones() []*CommunityMilestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *CommunityTemplate) GetMinContribution() int64 {
+	if x != nil {
+		return x.MinContribution
+	}
+	return 0
+}
+
+// 全服目标档位
+type CommunityMilestone struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskInfo []*OperateTaskInfo `protobuf:"bytes,1,rep,name=taskInfo,proto3" json:"taskInfo,omitempty"` // 任务进度信息
+	Target int64       `protobuf:"varint,1,opt,name=Target,proto3" json:"Target,omitempty"` // 全服进度目标
+	Reward []*ItemData `protobuf:"bytes,2,rep,name=Reward,proto3" json:"Reward,omitempty"`  // 奖励
 }
 
-func (x *ConditionTemplateDB) Reset() {
-	*x = ConditionTemplateDB{}
+func (x *CommunityMilestone) Reset() {
+	*x = CommunityMilestone{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,13 +2784,13 @@ func (x *ConditionTemplateDB) Reset() {
 	}
 }
 
-func (x *ConditionTemplateDB) String() string {
+func (x *CommunityMilestone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConditionTemplateDB) ProtoMessage() {}
+func (*CommunityMilestone) ProtoMessage() {}
 
-func (x *ConditionTemplateDB) ProtoReflect() protoreflect.Message {
+func (x *CommunityMilestone) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2020,30 +2802,38 @@ func (x *ConditionTemplateDB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConditionTemplateDB.ProtoReflect.Descriptor instead.
-func (*ConditionTemplateDB) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommunityMilestone.ProtoReflect.Descriptor instead.
+func (*CommunityMilestone) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ConditionTemplateDB) GetTaskInfo() []*OperateTaskInfo {
+func (x *CommunityMilestone) GetTarget() int64 {
 	if x != nil {
-		return x.TaskInfo
+		return x.Target
+	}
+	return 0
+}
+
+func (x *CommunityMilestone) GetReward() []*ItemData {
+	if x != nil {
+		return x.Reward
 	}
 	return nil
 }
 
-type Operate struct {
+// 通行证模板
+type BattlePassTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Detailed *OperateActivityDB `protobuf:"bytes,1,opt,name=detailed,proto3" json:"detailed,omitempty"` //活动详细信息
-	Conf     *OperateActivity   `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`         //活动配置信息
-	Day      int32              `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`          //开启天数
+	Tasks            []*BattlePassTask  `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`                        // 经验任务
+	Levels           []*BattlePassLevel `protobuf:"bytes,2,rep,name=Levels,proto3" json:"Levels,omitempty"`                      // 等级奖励,按所需经验从小到大配置
+	PremiumProductId int32              `protobuf:"varint,3,opt,name=PremiumProductId,proto3" json:"PremiumProductId,omitempty"` // 高级通行证商品Id,购买时传给IBattlePassPlayer.OperateBuyPremium
 }
 
-func (x *Operate) Reset() {
-	*x = Operate{}
+func (x *BattlePassTemplate) Reset() {
+	*x = BattlePassTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_global_operate_activity_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2051,13 +2841,13 @@ func (x *Operate) Reset() {
 	}
 }
 
-func (x *Operate) String() string {
+func (x *BattlePassTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operate) ProtoMessage() {}
+func (*BattlePassTemplate) ProtoMessage() {}
 
-func (x *Operate) ProtoReflect() protoreflect.Message {
+func (x *BattlePassTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_global_operate_activity_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2069,492 +2859,3689 @@ func (x *Operate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operate.ProtoReflect.Descriptor instead.
-func (*Operate) Descriptor() ([]byte, []int) {
+// Deprecated: Use BattlePassTemplate.ProtoReflect.Descriptor instead.
+func (*BattlePassTemplate) Descriptor() ([]byte, []int) {
 	return file_global_operate_activity_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Operate) GetDetailed() *OperateActivityDB {
+func (x *BattlePassTemplate) GetTasks() []*BattlePassTask {
 	if x != nil {
-		return x.Detailed
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *Operate) GetConf() *OperateActivity {
+func (x *BattlePassTemplate) GetLevels() []*BattlePassLevel {
 	if x != nil {
-		return x.Conf
+		return x.Levels
 	}
 	return nil
 }
 
-func (x *Operate) GetDay() int32 {
+func (x *BattlePassTemplate) GetPremiumProductId() int32 {
 	if x != nil {
-		return x.Day
+		return x.PremiumProductId
 	}
 	return 0
 }
 
-var File_global_operate_activity_proto protoreflect.FileDescriptor
+// 通行证经验任务
+type BattlePassTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_global_operate_activity_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x04, 0x47, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x4e, 0x75, 0x6d, 0x22, 0x5d, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55,
-	0x72, 0x6c, 0x22, 0xd1, 0x07, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x54, 0x69,
-	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x54, 0x69, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x50,
-	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x6e,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12,
-	0x2a, 0x0a, 0x10, 0x49, 0x73, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49, 0x73, 0x4e, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0c, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x10, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x50, 0x72, 0x65, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x35, 0x0a, 0x0b,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x11, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x49, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x55, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x4e, 0x65, 0x65,
-	0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x4e, 0x65, 0x65, 0x64, 0x50,
-	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x1a, 0x53, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0c, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a,
-	0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc9, 0x02, 0x0a, 0x10, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e,
-	0x12, 0x35, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x07, 0x4c, 0x6f,
-	0x74, 0x74, 0x65, 0x72, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x77, 0x0a, 0x10,
-	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x2d, 0x0a, 0x0a, 0x52, 0x53, 0x49, 0x5f, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x52, 0x53, 0x49, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x12,
-	0x34, 0x0a, 0x0d, 0x52, 0x53, 0x49, 0x5f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x52, 0x53, 0x49, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x53, 0x69, 0x67,
-	0x6e, 0x49, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xe4, 0x02, 0x0a, 0x0e, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e,
-	0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53,
-	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65,
-	0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67,
-	0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x61,
-	0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e,
-	0x49, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0a, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x41, 0x75,
-	0x74, 0x6f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52, 0x65,
-	0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52,
-	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x38, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05,
-	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x47, 0x6f, 0x6f,
-	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06,
-	0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x45, 0x78,
-	0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x48, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x6c, 0x47,
-	0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52,
-	0x09, 0x53, 0x65, 0x6c, 0x6c, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x07, 0x4c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x13, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x73,
-	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a,
-	0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47,
-	0x6f, 0x6f, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x43, 0x6f, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x0b, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x36, 0x0a, 0x0e, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65,
-	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x0a, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a, 0x0d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x34,
-	0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4e,
-	0x75, 0x6d, 0x22, 0x92, 0x03, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x0c, 0x50, 0x72, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x44, 0x0a,
-	0x09, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x2e, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x44, 0x42, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x55, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x39, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
-	0x97, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x51, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42,
-	0x12, 0x32, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x44, 0x42, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x08, 0x53, 0x69, 0x67, 0x6e,
-	0x49, 0x6e, 0x44, 0x42, 0x12, 0x41, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47,
-	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x42, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x48,
-	0x0a, 0x09, 0x42, 0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x42,
-	0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x42,
-	0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x42, 0x75, 0x79, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x02, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x49,
-	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x4c, 0x61, 0x73,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x34, 0x0a, 0x04, 0x47, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x47, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x47, 0x6f, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44,
-	0x61, 0x79, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x13, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52, 0x65, 0x70,
-	0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x47, 0x6f, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x3e, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x22, 0x48, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x7b, 0x0a, 0x07, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44,
-	0x42, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x63,
-	0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x2a, 0x66, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x54, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x42, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x45,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x47, 0x49, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50,
-	0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03,
-	0x2a, 0x85, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x54, 0x50,
-	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x49,
-	0x47, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
-	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x4f, 0x54, 0x54, 0x45, 0x52,
-	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x52,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x2a, 0x48, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x54,
-	0x52, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x54, 0x5f,
-	0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x54, 0x5f, 0x57, 0x45, 0x45,
-	0x4b, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
-	0x10, 0x03, 0x2a, 0x3f, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x54, 0x53, 0x5f, 0x44, 0x6f,
-	0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x54, 0x53, 0x5f, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x54, 0x53, 0x5f, 0x4f, 0x76, 0x65,
-	0x72, 0x10, 0x02, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	Task *Condition `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"` // 任务条件,任务完成时自动获得经验,奖励不生效
+	Xp   int64      `protobuf:"varint,2,opt,name=Xp,proto3" json:"Xp,omitempty"`    // 完成获得经验
 }
 
-var (
-	file_global_operate_activity_proto_rawDescOnce sync.Once
-	file_global_operate_activity_proto_rawDescData = file_global_operate_activity_proto_rawDesc
-)
+func (x *BattlePassTask) Reset() {
+	*x = BattlePassTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_global_operate_activity_proto_rawDescGZIP() []byte {
+func (x *BattlePassTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattlePassTask) ProtoMessage() {}
+
+func (x *BattlePassTask) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattlePassTask.ProtoReflect.Descriptor instead.
+func (*BattlePassTask) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BattlePassTask) GetTask() *Condition {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *BattlePassTask) GetXp() int64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+// 通行证等级
+type BattlePassLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xp            int64       `protobuf:"varint,1,opt,name=Xp,proto3" json:"Xp,omitempty"`                      // 达到该等级需要的累计经验
+	FreeReward    []*ItemData `protobuf:"bytes,2,rep,name=FreeReward,proto3" json:"FreeReward,omitempty"`       // 免费奖励
+	PremiumReward []*ItemData `protobuf:"bytes,3,rep,name=PremiumReward,proto3" json:"PremiumReward,omitempty"` // 高级奖励,解锁高级通行证后可领取
+}
+
+func (x *BattlePassLevel) Reset() {
+	*x = BattlePassLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BattlePassLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattlePassLevel) ProtoMessage() {}
+
+func (x *BattlePassLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattlePassLevel.ProtoReflect.Descriptor instead.
+func (*BattlePassLevel) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BattlePassLevel) GetXp() int64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *BattlePassLevel) GetFreeReward() []*ItemData {
+	if x != nil {
+		return x.FreeReward
+	}
+	return nil
+}
+
+func (x *BattlePassLevel) GetPremiumReward() []*ItemData {
+	if x != nil {
+		return x.PremiumReward
+	}
+	return nil
+}
+
+// 任务链模板
+type ChainTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*ChainStep `protobuf:"bytes,1,rep,name=Steps,proto3" json:"Steps,omitempty"` // 任务链步骤
+}
+
+func (x *ChainTemplate) Reset() {
+	*x = ChainTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainTemplate) ProtoMessage() {}
+
+func (x *ChainTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainTemplate.ProtoReflect.Descriptor instead.
+func (*ChainTemplate) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChainTemplate) GetSteps() []*ChainStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// 任务链步骤
+type ChainStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branches []*ChainBranch `protobuf:"bytes,1,rep,name=Branches,proto3" json:"Branches,omitempty"` // 分支,多个分支时同时进行,领取其中一个分支奖励后按该分支进入下一步骤
+}
+
+func (x *ChainStep) Reset() {
+	*x = ChainStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainStep) ProtoMessage() {}
+
+func (x *ChainStep) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainStep.ProtoReflect.Descriptor instead.
+func (*ChainStep) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChainStep) GetBranches() []*ChainBranch {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+// 任务链分支
+type ChainBranch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Condition `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`  // 任务
+	Next int32      `protobuf:"varint,2,opt,name=Next,proto3" json:"Next,omitempty"` // 领奖后进入的步骤(从1开始),0:顺序进入下一步骤,超出步骤数量时任务链结束
+}
+
+func (x *ChainBranch) Reset() {
+	*x = ChainBranch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainBranch) ProtoMessage() {}
+
+func (x *ChainBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainBranch.ProtoReflect.Descriptor instead.
+func (*ChainBranch) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChainBranch) GetTask() *Condition {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ChainBranch) GetNext() int32 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+// 充值模板
+type RechargeTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string          `protobuf:"bytes,1,opt,name=Currency,proto3" json:"Currency,omitempty"` // 统计的货币类型,为空时统计所有货币
+	Tiers    []*RechargeTier `protobuf:"bytes,2,rep,name=Tiers,proto3" json:"Tiers,omitempty"`       // 充值档位
+}
+
+func (x *RechargeTemplate) Reset() {
+	*x = RechargeTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RechargeTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeTemplate) ProtoMessage() {}
+
+func (x *RechargeTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeTemplate.ProtoReflect.Descriptor instead.
+func (*RechargeTemplate) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RechargeTemplate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RechargeTemplate) GetTiers() []*RechargeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// 充值档位
+type RechargeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   RechargeTierType `protobuf:"varint,1,opt,name=Type,proto3,enum=Game.RechargeTierType" json:"Type,omitempty"` // 档位类型
+	Amount int64            `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`                        // 充值金额
+	Reward []*ItemData      `protobuf:"bytes,3,rep,name=Reward,proto3" json:"Reward,omitempty"`                         // 奖励
+	Times  int32            `protobuf:"varint,4,opt,name=Times,proto3" json:"Times,omitempty"`                          // 单笔充值档位可领取次数,小于等于1时只能领取一次
+}
+
+func (x *RechargeTier) Reset() {
+	*x = RechargeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RechargeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeTier) ProtoMessage() {}
+
+func (x *RechargeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeTier.ProtoReflect.Descriptor instead.
+func (*RechargeTier) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RechargeTier) GetType() RechargeTierType {
+	if x != nil {
+		return x.Type
+	}
+	return RechargeTierType_RTT_CUMULATIVE
+}
+
+func (x *RechargeTier) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeTier) GetReward() []*ItemData {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *RechargeTier) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+// 排名奖励
+type RankReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinRank int32       `protobuf:"varint,1,opt,name=MinRank,proto3" json:"MinRank,omitempty"` // 最高名次(包含)
+	MaxRank int32       `protobuf:"varint,2,opt,name=MaxRank,proto3" json:"MaxRank,omitempty"` // 最低名次(包含)
+	Reward  []*ItemData `protobuf:"bytes,3,rep,name=Reward,proto3" json:"Reward,omitempty"`    // 奖励
+}
+
+func (x *RankReward) Reset() {
+	*x = RankReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankReward) ProtoMessage() {}
+
+func (x *RankReward) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankReward.ProtoReflect.Descriptor instead.
+func (*RankReward) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RankReward) GetMinRank() int32 {
+	if x != nil {
+		return x.MinRank
+	}
+	return 0
+}
+
+func (x *RankReward) GetMaxRank() int32 {
+	if x != nil {
+		return x.MaxRank
+	}
+	return 0
+}
+
+func (x *RankReward) GetReward() []*ItemData {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+// 奖池
+type RewardPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reward      *ItemData `protobuf:"bytes,1,opt,name=Reward,proto3" json:"Reward,omitempty"`
+	WeightValue int32     `protobuf:"varint,2,opt,name=WeightValue,proto3" json:"WeightValue,omitempty"`
+}
+
+func (x *RewardPool) Reset() {
+	*x = RewardPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardPool) ProtoMessage() {}
+
+func (x *RewardPool) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardPool.ProtoReflect.Descriptor instead.
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RewardPool) GetReward() *ItemData {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *RewardPool) GetWeightValue() int32 {
+	if x != nil {
+		return x.WeightValue
+	}
+	return 0
+}
+
+// 积分模板
+type ScoreTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score  *ItemData   `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"`   // 积分道具
+	Reward []*ItemData `protobuf:"bytes,2,rep,name=Reward,proto3" json:"Reward,omitempty"` // 对应积分奖励
+}
+
+func (x *ScoreTemplate) Reset() {
+	*x = ScoreTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreTemplate) ProtoMessage() {}
+
+func (x *ScoreTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreTemplate.ProtoReflect.Descriptor instead.
+func (*ScoreTemplate) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScoreTemplate) GetScore() *ItemData {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+func (x *ScoreTemplate) GetReward() []*ItemData {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+// 任务数据
+type OperateTaskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId      int32            `protobuf:"varint,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	TaskState   OperateTaskState `protobuf:"varint,2,opt,name=taskState,proto3,enum=Game.OperateTaskState" json:"taskState,omitempty"` // 任务状态 0:进行中, 1:完成未领奖  2：完成已领奖
+	Progress    int32            `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`                              // 任务进度
+	CompleteNum int32            `protobuf:"varint,4,opt,name=completeNum,proto3" json:"completeNum,omitempty"`                        // 完成次数
+}
+
+func (x *OperateTaskInfo) Reset() {
+	*x = OperateTaskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateTaskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateTaskInfo) ProtoMessage() {}
+
+func (x *OperateTaskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateTaskInfo.ProtoReflect.Descriptor instead.
+func (*OperateTaskInfo) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OperateTaskInfo) GetTaskId() int32 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *OperateTaskInfo) GetTaskState() OperateTaskState {
+	if x != nil {
+		return x.TaskState
+	}
+	return OperateTaskState_OTS_Doing
+}
+
+func (x *OperateTaskInfo) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *OperateTaskInfo) GetCompleteNum() int32 {
+	if x != nil {
+		return x.CompleteNum
+	}
+	return 0
+}
+
+type OperateActivityDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityId   int64                     `protobuf:"varint,1,opt,name=ActivityId,proto3" json:"ActivityId,omitempty"`                                                                                             // 活动ID
+	PreTaskGroup []*TaskGroup              `protobuf:"bytes,2,rep,name=PreTaskGroup,proto3" json:"PreTaskGroup,omitempty"`                                                                                          // 前置任务进度信息
+	GotScores    map[int32]bool            `protobuf:"bytes,3,rep,name=GotScores,proto3" json:"GotScores,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`      // 已领取积分信息  key:OperateActivity.ScoreTemplate 数组索引  value:是否开启
+	ActivityList map[int32]*ActivityDBList `protobuf:"bytes,4,rep,name=ActivityList,proto3" json:"ActivityList,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 活动模板ID组  key:活动天数  value：活动模板列表
+}
+
+func (x *OperateActivityDB) Reset() {
+	*x = OperateActivityDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateActivityDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateActivityDB) ProtoMessage() {}
+
+func (x *OperateActivityDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateActivityDB.ProtoReflect.Descriptor instead.
+func (*OperateActivityDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OperateActivityDB) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *OperateActivityDB) GetPreTaskGroup() []*TaskGroup {
+	if x != nil {
+		return x.PreTaskGroup
+	}
+	return nil
+}
+
+func (x *OperateActivityDB) GetGotScores() map[int32]bool {
+	if x != nil {
+		return x.GotScores
+	}
+	return nil
+}
+
+func (x *OperateActivityDB) GetActivityList() map[int32]*ActivityDBList {
+	if x != nil {
+		return x.ActivityList
+	}
+	return nil
+}
+
+// 玩家活动记录,活动删除后保留,用于活动依赖判断
+type OperatePlayerDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records map[int64]*OperateActivityRecord `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 活动记录  key:活动Id
+}
+
+func (x *OperatePlayerDB) Reset() {
+	*x = OperatePlayerDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePlayerDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePlayerDB) ProtoMessage() {}
+
+func (x *OperatePlayerDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePlayerDB.ProtoReflect.Descriptor instead.
+func (*OperatePlayerDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OperatePlayerDB) GetRecords() map[int64]*OperateActivityRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type OperateActivityRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Completed     bool  `protobuf:"varint,1,opt,name=Completed,proto3" json:"Completed,omitempty"`         // 是否完成
+	FinalScoreGot bool  `protobuf:"varint,2,opt,name=FinalScoreGot,proto3" json:"FinalScoreGot,omitempty"` // 最后一档积分奖励是否已领取
+	UpdateTime    int64 `protobuf:"varint,3,opt,name=UpdateTime,proto3" json:"UpdateTime,omitempty"`       // 记录更新时间
+}
+
+func (x *OperateActivityRecord) Reset() {
+	*x = OperateActivityRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateActivityRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateActivityRecord) ProtoMessage() {}
+
+func (x *OperateActivityRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateActivityRecord.ProtoReflect.Descriptor instead.
+func (*OperateActivityRecord) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OperateActivityRecord) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *OperateActivityRecord) GetFinalScoreGot() bool {
+	if x != nil {
+		return x.FinalScoreGot
+	}
+	return false
+}
+
+func (x *OperateActivityRecord) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type TaskGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreTaskInfos []*OperateTaskInfo `protobuf:"bytes,1,rep,name=PreTaskInfos,proto3" json:"PreTaskInfos,omitempty"` // 前置任务进度信息
+}
+
+func (x *TaskGroup) Reset() {
+	*x = TaskGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskGroup) ProtoMessage() {}
+
+func (x *TaskGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskGroup.ProtoReflect.Descriptor instead.
+func (*TaskGroup) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TaskGroup) GetPreTaskInfos() []*OperateTaskInfo {
+	if x != nil {
+		return x.PreTaskInfos
+	}
+	return nil
+}
+
+// 活动列表
+type ActivityDBList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List map[int32]*ActivityTemplateDB `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 活动模板列表  key：模板索引  value：模板数据
+}
+
+func (x *ActivityDBList) Reset() {
+	*x = ActivityDBList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityDBList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDBList) ProtoMessage() {}
+
+func (x *ActivityDBList) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDBList.ProtoReflect.Descriptor instead.
+func (*ActivityDBList) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ActivityDBList) GetList() map[int32]*ActivityTemplateDB {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ActivityTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignInDB      *SignInTemplateDB      `protobuf:"bytes,1,opt,name=SignInDB,proto3" json:"SignInDB,omitempty"`
+	ConsumptionDB *ConsumptionTemplateDB `protobuf:"bytes,2,opt,name=ConsumptionDB,proto3" json:"ConsumptionDB,omitempty"`
+	ConditionDB   *ConditionTemplateDB   `protobuf:"bytes,3,opt,name=ConditionDB,proto3" json:"ConditionDB,omitempty"`
+	LotteryDB     *LotteryTemplateDB     `protobuf:"bytes,4,opt,name=LotteryDB,proto3" json:"LotteryDB,omitempty"`
+	Extension     *anypb.Any             `protobuf:"bytes,5,opt,name=Extension,proto3" json:"Extension,omitempty"` // 自定义模板存档
+	RankingDB     *RankingTemplateDB     `protobuf:"bytes,6,opt,name=RankingDB,proto3" json:"RankingDB,omitempty"`
+	GuildDB       *GuildTemplateDB       `protobuf:"bytes,7,opt,name=GuildDB,proto3" json:"GuildDB,omitempty"`
+	CommunityDB   *CommunityTemplateDB   `protobuf:"bytes,8,opt,name=CommunityDB,proto3" json:"CommunityDB,omitempty"`
+	BattlePassDB  *BattlePassTemplateDB  `protobuf:"bytes,9,opt,name=BattlePassDB,proto3" json:"BattlePassDB,omitempty"`
+	RechargeDB    *RechargeTemplateDB    `protobuf:"bytes,10,opt,name=RechargeDB,proto3" json:"RechargeDB,omitempty"`
+	ChainDB       *ChainTemplateDB       `protobuf:"bytes,11,opt,name=ChainDB,proto3" json:"ChainDB,omitempty"`
+}
+
+func (x *ActivityTemplateDB) Reset() {
+	*x = ActivityTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTemplateDB) ProtoMessage() {}
+
+func (x *ActivityTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTemplateDB.ProtoReflect.Descriptor instead.
+func (*ActivityTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ActivityTemplateDB) GetSignInDB() *SignInTemplateDB {
+	if x != nil {
+		return x.SignInDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetConsumptionDB() *ConsumptionTemplateDB {
+	if x != nil {
+		return x.ConsumptionDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetConditionDB() *ConditionTemplateDB {
+	if x != nil {
+		return x.ConditionDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetLotteryDB() *LotteryTemplateDB {
+	if x != nil {
+		return x.LotteryDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetExtension() *anypb.Any {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetRankingDB() *RankingTemplateDB {
+	if x != nil {
+		return x.RankingDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetGuildDB() *GuildTemplateDB {
+	if x != nil {
+		return x.GuildDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetCommunityDB() *CommunityTemplateDB {
+	if x != nil {
+		return x.CommunityDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetBattlePassDB() *BattlePassTemplateDB {
+	if x != nil {
+		return x.BattlePassDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetRechargeDB() *RechargeTemplateDB {
+	if x != nil {
+		return x.RechargeDB
+	}
+	return nil
+}
+
+func (x *ActivityTemplateDB) GetChainDB() *ChainTemplateDB {
+	if x != nil {
+		return x.ChainDB
+	}
+	return nil
+}
+
+type GuildTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claimed  map[int32]bool     `protobuf:"bytes,1,rep,name=Claimed,proto3" json:"Claimed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领奖任务,与所在公会无关,换公会后不可重复领取
+	TaskInfo []*OperateTaskInfo `protobuf:"bytes,2,rep,name=TaskInfo,proto3" json:"TaskInfo,omitempty"`                                                                                         // 个人任务进度,与上次上报的差值累加到公会进度
+}
+
+func (x *GuildTemplateDB) Reset() {
+	*x = GuildTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuildTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildTemplateDB) ProtoMessage() {}
+
+func (x *GuildTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildTemplateDB.ProtoReflect.Descriptor instead.
+func (*GuildTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GuildTemplateDB) GetClaimed() map[int32]bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return nil
+}
+
+func (x *GuildTemplateDB) GetTaskInfo() []*OperateTaskInfo {
+	if x != nil {
+		return x.TaskInfo
+	}
+	return nil
+}
+
+type CommunityTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claimed      map[int32]bool   `protobuf:"bytes,1,rep,name=Claimed,proto3" json:"Claimed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领奖档位
+	Contribution int64            `protobuf:"varint,2,opt,name=Contribution,proto3" json:"Contribution,omitempty"`                                                                                // 个人贡献
+	TaskInfo     *OperateTaskInfo `protobuf:"bytes,3,opt,name=TaskInfo,proto3" json:"TaskInfo,omitempty"`                                                                                         // 个人任务进度,与上次上报的差值累加到全服进度
+}
+
+func (x *CommunityTemplateDB) Reset() {
+	*x = CommunityTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommunityTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityTemplateDB) ProtoMessage() {}
+
+func (x *CommunityTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityTemplateDB.ProtoReflect.Descriptor instead.
+func (*CommunityTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CommunityTemplateDB) GetClaimed() map[int32]bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return nil
+}
+
+func (x *CommunityTemplateDB) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *CommunityTemplateDB) GetTaskInfo() *OperateTaskInfo {
+	if x != nil {
+		return x.TaskInfo
+	}
+	return nil
+}
+
+type BattlePassTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xp             int64              `protobuf:"varint,1,opt,name=Xp,proto3" json:"Xp,omitempty"`                                                                                                                  // 累计经验
+	Premium        bool               `protobuf:"varint,2,opt,name=Premium,proto3" json:"Premium,omitempty"`                                                                                                        // 是否解锁高级通行证
+	FreeClaimed    map[int32]bool     `protobuf:"bytes,3,rep,name=FreeClaimed,proto3" json:"FreeClaimed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`       // 已领取免费奖励 key:等级索引
+	PremiumClaimed map[int32]bool     `protobuf:"bytes,4,rep,name=PremiumClaimed,proto3" json:"PremiumClaimed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领取高级奖励 key:等级索引
+	TaskInfo       []*OperateTaskInfo `protobuf:"bytes,5,rep,name=TaskInfo,proto3" json:"TaskInfo,omitempty"`                                                                                                       // 经验任务进度
+}
+
+func (x *BattlePassTemplateDB) Reset() {
+	*x = BattlePassTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BattlePassTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattlePassTemplateDB) ProtoMessage() {}
+
+func (x *BattlePassTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattlePassTemplateDB.ProtoReflect.Descriptor instead.
+func (*BattlePassTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BattlePassTemplateDB) GetXp() int64 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *BattlePassTemplateDB) GetPremium() bool {
+	if x != nil {
+		return x.Premium
+	}
+	return false
+}
+
+func (x *BattlePassTemplateDB) GetFreeClaimed() map[int32]bool {
+	if x != nil {
+		return x.FreeClaimed
+	}
+	return nil
+}
+
+func (x *BattlePassTemplateDB) GetPremiumClaimed() map[int32]bool {
+	if x != nil {
+		return x.PremiumClaimed
+	}
+	return nil
+}
+
+func (x *BattlePassTemplateDB) GetTaskInfo() []*OperateTaskInfo {
+	if x != nil {
+		return x.TaskInfo
+	}
+	return nil
+}
+
+type ChainTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Step     int32              `protobuf:"varint,1,opt,name=Step,proto3" json:"Step,omitempty"`         // 当前步骤索引
+	TaskInfo []*OperateTaskInfo `protobuf:"bytes,2,rep,name=TaskInfo,proto3" json:"TaskInfo,omitempty"`  // 当前步骤各分支任务进度
+	History  []*ChainRecord     `protobuf:"bytes,3,rep,name=History,proto3" json:"History,omitempty"`    // 已完成的步骤
+	Finished bool               `protobuf:"varint,4,opt,name=Finished,proto3" json:"Finished,omitempty"` // 任务链是否结束
+}
+
+func (x *ChainTemplateDB) Reset() {
+	*x = ChainTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainTemplateDB) ProtoMessage() {}
+
+func (x *ChainTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainTemplateDB.ProtoReflect.Descriptor instead.
+func (*ChainTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChainTemplateDB) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ChainTemplateDB) GetTaskInfo() []*OperateTaskInfo {
+	if x != nil {
+		return x.TaskInfo
+	}
+	return nil
+}
+
+func (x *ChainTemplateDB) GetHistory() []*ChainRecord {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *ChainTemplateDB) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type ChainRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Step   int32 `protobuf:"varint,1,opt,name=Step,proto3" json:"Step,omitempty"`     // 步骤索引
+	Branch int32 `protobuf:"varint,2,opt,name=Branch,proto3" json:"Branch,omitempty"` // 选择的分支索引
+}
+
+func (x *ChainRecord) Reset() {
+	*x = ChainRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainRecord) ProtoMessage() {}
+
+func (x *ChainRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainRecord.ProtoReflect.Descriptor instead.
+func (*ChainRecord) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChainRecord) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ChainRecord) GetBranch() int32 {
+	if x != nil {
+		return x.Branch
+	}
+	return 0
+}
+
+type RechargeTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int64           `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`                                                                                                      // 累计充值金额
+	ReachCounts map[int32]int32 `protobuf:"bytes,2,rep,name=ReachCounts,proto3" json:"ReachCounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 单笔充值档位达成次数 key:档位索引
+	ClaimCounts map[int32]int32 `protobuf:"bytes,3,rep,name=ClaimCounts,proto3" json:"ClaimCounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领取次数 key:档位索引
+	OrderIds    []string        `protobuf:"bytes,4,rep,name=OrderIds,proto3" json:"OrderIds,omitempty"`                                                                                                 // 已处理的订单Id,活动期间全部保留
+}
+
+func (x *RechargeTemplateDB) Reset() {
+	*x = RechargeTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RechargeTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeTemplateDB) ProtoMessage() {}
+
+func (x *RechargeTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeTemplateDB.ProtoReflect.Descriptor instead.
+func (*RechargeTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RechargeTemplateDB) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RechargeTemplateDB) GetReachCounts() map[int32]int32 {
+	if x != nil {
+		return x.ReachCounts
+	}
+	return nil
+}
+
+func (x *RechargeTemplateDB) GetClaimCounts() map[int32]int32 {
+	if x != nil {
+		return x.ClaimCounts
+	}
+	return nil
+}
+
+func (x *RechargeTemplateDB) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type RankingTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score      int64 `protobuf:"varint,1,opt,name=Score,proto3" json:"Score,omitempty"`           // 活动积分
+	Settled    bool  `protobuf:"varint,2,opt,name=Settled,proto3" json:"Settled,omitempty"`       // 是否已结算
+	SettleRank int32 `protobuf:"varint,3,opt,name=SettleRank,proto3" json:"SettleRank,omitempty"` // 结算名次,0:未上榜
+}
+
+func (x *RankingTemplateDB) Reset() {
+	*x = RankingTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankingTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankingTemplateDB) ProtoMessage() {}
+
+func (x *RankingTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankingTemplateDB.ProtoReflect.Descriptor instead.
+func (*RankingTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RankingTemplateDB) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RankingTemplateDB) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *RankingTemplateDB) GetSettleRank() int32 {
+	if x != nil {
+		return x.SettleRank
+	}
+	return 0
+}
+
+type ConsumptionTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyCounts map[int32]int32 `protobuf:"bytes,1,rep,name=BuyCounts,proto3" json:"BuyCounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已购买次数
+}
+
+func (x *ConsumptionTemplateDB) Reset() {
+	*x = ConsumptionTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumptionTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumptionTemplateDB) ProtoMessage() {}
+
+func (x *ConsumptionTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumptionTemplateDB.ProtoReflect.Descriptor instead.
+func (*ConsumptionTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConsumptionTemplateDB) GetBuyCounts() map[int32]int32 {
+	if x != nil {
+		return x.BuyCounts
+	}
+	return nil
+}
+
+type LotteryTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DrawCounts         map[int32]int32 `protobuf:"bytes,1,rep,name=DrawCounts,proto3" json:"DrawCounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 抽奖次数 key:LotteryTemplate.LotteryList 数组索引
+	GuaranteedProgress int32           `protobuf:"varint,2,opt,name=GuaranteedProgress,proto3" json:"GuaranteedProgress,omitempty"`                                                                          // 保底进度(所有抽奖共享)
+	TotalCount         int32           `protobuf:"varint,3,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`                                                                                          // 总抽取次数
+}
+
+func (x *LotteryTemplateDB) Reset() {
+	*x = LotteryTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotteryTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotteryTemplateDB) ProtoMessage() {}
+
+func (x *LotteryTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotteryTemplateDB.ProtoReflect.Descriptor instead.
+func (*LotteryTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LotteryTemplateDB) GetDrawCounts() map[int32]int32 {
+	if x != nil {
+		return x.DrawCounts
+	}
+	return nil
+}
+
+func (x *LotteryTemplateDB) GetGuaranteedProgress() int32 {
+	if x != nil {
+		return x.GuaranteedProgress
+	}
+	return 0
+}
+
+func (x *LotteryTemplateDB) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type SignInTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedDay           int32              `protobuf:"varint,1,opt,name=SignedDay,proto3" json:"SignedDay,omitempty"`                                                                                // 已签到天数
+	LastSignTimestamp   int64              `protobuf:"varint,2,opt,name=LastSignTimestamp,proto3" json:"LastSignTimestamp,omitempty"`                                                                // 上次签到时间戳
+	Conditions          []*RepairCondition `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`                                                                               // 补签任务进度
+	RepairCount         int32              `protobuf:"varint,4,opt,name=RepairCount,proto3" json:"RepairCount,omitempty"`                                                                            // 补签次数
+	Gots                map[int32]bool     `protobuf:"bytes,5,rep,name=Gots,proto3" json:"Gots,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 已领取奖励
+	EveryDayRepairCount int32              `protobuf:"varint,6,opt,name=EveryDayRepairCount,proto3" json:"EveryDayRepairCount,omitempty"`                                                            // 每日已补签次数
+	Streak              int32              `protobuf:"varint,7,opt,name=Streak,proto3" json:"Streak,omitempty"`                                                                                      // 当前连续签到天数
+	MaxStreak           int32              `protobuf:"varint,8,opt,name=MaxStreak,proto3" json:"MaxStreak,omitempty"`                                                                                // 最大连续签到天数
+	SignedDates         []int32            `protobuf:"varint,9,rep,packed,name=SignedDates,proto3" json:"SignedDates,omitempty"`                                                                     // 月历签到:已签到日期(yyyymmdd),Gots同样以日期为key
+}
+
+func (x *SignInTemplateDB) Reset() {
+	*x = SignInTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignInTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInTemplateDB) ProtoMessage() {}
+
+func (x *SignInTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInTemplateDB.ProtoReflect.Descriptor instead.
+func (*SignInTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SignInTemplateDB) GetSignedDay() int32 {
+	if x != nil {
+		return x.SignedDay
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetLastSignTimestamp() int64 {
+	if x != nil {
+		return x.LastSignTimestamp
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetConditions() []*RepairCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SignInTemplateDB) GetRepairCount() int32 {
+	if x != nil {
+		return x.RepairCount
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetGots() map[int32]bool {
+	if x != nil {
+		return x.Gots
+	}
+	return nil
+}
+
+func (x *SignInTemplateDB) GetEveryDayRepairCount() int32 {
+	if x != nil {
+		return x.EveryDayRepairCount
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetMaxStreak() int32 {
+	if x != nil {
+		return x.MaxStreak
+	}
+	return 0
+}
+
+func (x *SignInTemplateDB) GetSignedDates() []int32 {
+	if x != nil {
+		return x.SignedDates
+	}
+	return nil
+}
+
+type RepairCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*OperateTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // 补签任务进度
+}
+
+func (x *RepairCondition) Reset() {
+	*x = RepairCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairCondition) ProtoMessage() {}
+
+func (x *RepairCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairCondition.ProtoReflect.Descriptor instead.
+func (*RepairCondition) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RepairCondition) GetTasks() []*OperateTaskInfo {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ConditionTemplateDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskInfo []*OperateTaskInfo `protobuf:"bytes,1,rep,name=taskInfo,proto3" json:"taskInfo,omitempty"` // 任务进度信息
+}
+
+func (x *ConditionTemplateDB) Reset() {
+	*x = ConditionTemplateDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionTemplateDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionTemplateDB) ProtoMessage() {}
+
+func (x *ConditionTemplateDB) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionTemplateDB.ProtoReflect.Descriptor instead.
+func (*ConditionTemplateDB) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ConditionTemplateDB) GetTaskInfo() []*OperateTaskInfo {
+	if x != nil {
+		return x.TaskInfo
+	}
+	return nil
+}
+
+type Operate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detailed       *OperateActivityDB       `protobuf:"bytes,1,opt,name=detailed,proto3" json:"detailed,omitempty"`             //活动详细信息
+	Conf           *OperateActivity         `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`                     //活动配置信息
+	Day            int32                    `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`                      //开启天数
+	Templates      []*OperateTemplateClient `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`           //模板派生数据
+	PreCond        *OperatePreCondNode      `protobuf:"bytes,5,opt,name=preCond,proto3" json:"preCond,omitempty"`               //前置条件进度,无前置条件时为空
+	CommunityGoals []*OperateCommunityGoal  `protobuf:"bytes,6,rep,name=communityGoals,proto3" json:"communityGoals,omitempty"` //全服目标进度
+}
+
+func (x *Operate) Reset() {
+	*x = Operate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operate) ProtoMessage() {}
+
+func (x *Operate) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operate.ProtoReflect.Descriptor instead.
+func (*Operate) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Operate) GetDetailed() *OperateActivityDB {
+	if x != nil {
+		return x.Detailed
+	}
+	return nil
+}
+
+func (x *Operate) GetConf() *OperateActivity {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+func (x *Operate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Operate) GetTemplates() []*OperateTemplateClient {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *Operate) GetPreCond() *OperatePreCondNode {
+	if x != nil {
+		return x.PreCond
+	}
+	return nil
+}
+
+func (x *Operate) GetCommunityGoals() []*OperateCommunityGoal {
+	if x != nil {
+		return x.CommunityGoals
+	}
+	return nil
+}
+
+//全服目标进度
+type OperateCommunityGoal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TplIndex     int32               `protobuf:"varint,1,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`         //模板索引
+	Progress     int64               `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`         //全服进度
+	Contribution int64               `protobuf:"varint,3,opt,name=contribution,proto3" json:"contribution,omitempty"` //个人贡献
+	Milestones   []*OperateMilestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones,omitempty"`      //档位状态
+}
+
+func (x *OperateCommunityGoal) Reset() {
+	*x = OperateCommunityGoal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateCommunityGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateCommunityGoal) ProtoMessage() {}
+
+func (x *OperateCommunityGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateCommunityGoal.ProtoReflect.Descriptor instead.
+func (*OperateCommunityGoal) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *OperateCommunityGoal) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateCommunityGoal) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *OperateCommunityGoal) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *OperateCommunityGoal) GetMilestones() []*OperateMilestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+// 全服目标档位状态
+type OperateMilestone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`     //档位索引
+	Target  int64 `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`   //全服进度目标
+	Reached bool  `protobuf:"varint,3,opt,name=reached,proto3" json:"reached,omitempty"` //是否达成
+	Claimed bool  `protobuf:"varint,4,opt,name=claimed,proto3" json:"claimed,omitempty"` //是否已领取
+}
+
+func (x *OperateMilestone) Reset() {
+	*x = OperateMilestone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateMilestone) ProtoMessage() {}
+
+func (x *OperateMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateMilestone.ProtoReflect.Descriptor instead.
+func (*OperateMilestone) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *OperateMilestone) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *OperateMilestone) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *OperateMilestone) GetReached() bool {
+	if x != nil {
+		return x.Reached
+	}
+	return false
+}
+
+func (x *OperateMilestone) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+// 前置条件节点进度,与PreCondNode结构一致,未配置PreCondTree时根据PreConditionGroup生成
+type OperatePreCondNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          PreCondNodeType       `protobuf:"varint,1,opt,name=type,proto3,enum=Game.PreCondNodeType" json:"type,omitempty"` //节点类型
+	Finished      bool                  `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`                   //是否完成
+	FinishedCount int32                 `protobuf:"varint,3,opt,name=finishedCount,proto3" json:"finishedCount,omitempty"`         //已完成子节点数,叶子节点为0或1
+	NeedCount     int32                 `protobuf:"varint,4,opt,name=needCount,proto3" json:"needCount,omitempty"`                 //需要完成子节点数
+	Children      []*OperatePreCondNode `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`                    //子节点
+}
+
+func (x *OperatePreCondNode) Reset() {
+	*x = OperatePreCondNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperatePreCondNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatePreCondNode) ProtoMessage() {}
+
+func (x *OperatePreCondNode) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatePreCondNode.ProtoReflect.Descriptor instead.
+func (*OperatePreCondNode) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *OperatePreCondNode) GetType() PreCondNodeType {
+	if x != nil {
+		return x.Type
+	}
+	return PreCondNodeType_PCN_LEAF
+}
+
+func (x *OperatePreCondNode) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *OperatePreCondNode) GetFinishedCount() int32 {
+	if x != nil {
+		return x.FinishedCount
+	}
+	return 0
+}
+
+func (x *OperatePreCondNode) GetNeedCount() int32 {
+	if x != nil {
+		return x.NeedCount
+	}
+	return 0
+}
+
+func (x *OperatePreCondNode) GetChildren() []*OperatePreCondNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// 模板派生数据,由服务器根据配置和存档计算
+type OperateTemplateClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day         int32                `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`                //模板所属天
+	TplIndex    int32                `protobuf:"varint,2,opt,name=tplIndex,proto3" json:"tplIndex,omitempty"`      //模板索引
+	GoodsPrices []*OperateGoodsPrice `protobuf:"bytes,3,rep,name=goodsPrices,proto3" json:"goodsPrices,omitempty"` //商品当前价格
+	Streak      int32                `protobuf:"varint,4,opt,name=streak,proto3" json:"streak,omitempty"`          //当前连续签到天数(已按断签规则计算,未计算保护道具)
+	Today       int32                `protobuf:"varint,5,opt,name=today,proto3" json:"today,omitempty"`            //月历签到:今天日期(yyyymmdd)
+	Rank        int32                `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`              //排行:当前名次,0:未上榜
+	RankScore   int64                `protobuf:"varint,7,opt,name=rankScore,proto3" json:"rankScore,omitempty"`    //排行:当前积分
+	GuildTasks  []*OperateGuildTask  `protobuf:"bytes,8,rep,name=guildTasks,proto3" json:"guildTasks,omitempty"`   //公会:任务进度
+	PassLevel   int32                `protobuf:"varint,9,opt,name=passLevel,proto3" json:"passLevel,omitempty"`    //通行证:当前等级(已达到的等级数量)
+}
+
+func (x *OperateTemplateClient) Reset() {
+	*x = OperateTemplateClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateTemplateClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateTemplateClient) ProtoMessage() {}
+
+func (x *OperateTemplateClient) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateTemplateClient.ProtoReflect.Descriptor instead.
+func (*OperateTemplateClient) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *OperateTemplateClient) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetTplIndex() int32 {
+	if x != nil {
+		return x.TplIndex
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetGoodsPrices() []*OperateGoodsPrice {
+	if x != nil {
+		return x.GoodsPrices
+	}
+	return nil
+}
+
+func (x *OperateTemplateClient) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetToday() int32 {
+	if x != nil {
+		return x.Today
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetRankScore() int64 {
+	if x != nil {
+		return x.RankScore
+	}
+	return 0
+}
+
+func (x *OperateTemplateClient) GetGuildTasks() []*OperateGuildTask {
+	if x != nil {
+		return x.GuildTasks
+	}
+	return nil
+}
+
+func (x *OperateTemplateClient) GetPassLevel() int32 {
+	if x != nil {
+		return x.PassLevel
+	}
+	return 0
+}
+
+// 公会任务进度
+type OperateGuildTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskIndex    int32            `protobuf:"varint,1,opt,name=taskIndex,proto3" json:"taskIndex,omitempty"`                            //任务索引
+	Progress     int64            `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`                              //公会总进度
+	Target       int64            `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`                                  //公会总进度目标
+	Contribution int64            `protobuf:"varint,4,opt,name=contribution,proto3" json:"contribution,omitempty"`                      //个人在当前公会的贡献
+	TaskState    OperateTaskState `protobuf:"varint,5,opt,name=taskState,proto3,enum=Game.OperateTaskState" json:"taskState,omitempty"` //任务状态
+}
+
+func (x *OperateGuildTask) Reset() {
+	*x = OperateGuildTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateGuildTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateGuildTask) ProtoMessage() {}
+
+func (x *OperateGuildTask) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateGuildTask.ProtoReflect.Descriptor instead.
+func (*OperateGuildTask) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OperateGuildTask) GetTaskIndex() int32 {
+	if x != nil {
+		return x.TaskIndex
+	}
+	return 0
+}
+
+func (x *OperateGuildTask) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *OperateGuildTask) GetTarget() int64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *OperateGuildTask) GetContribution() int64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *OperateGuildTask) GetTaskState() OperateTaskState {
+	if x != nil {
+		return x.TaskState
+	}
+	return OperateTaskState_OTS_Doing
+}
+
+// 排行榜条目
+type OperateRankItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId int32 `protobuf:"varint,1,opt,name=playerId,proto3" json:"playerId,omitempty"` //玩家Id
+	Rank     int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`         //名次
+	Score    int64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`       //积分
+}
+
+func (x *OperateRankItem) Reset() {
+	*x = OperateRankItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateRankItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateRankItem) ProtoMessage() {}
+
+func (x *OperateRankItem) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateRankItem.ProtoReflect.Descriptor instead.
+func (*OperateRankItem) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *OperateRankItem) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *OperateRankItem) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *OperateRankItem) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+//商品当前价格
+type OperateGoodsPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoodsIndex  int32       `protobuf:"varint,1,opt,name=goodsIndex,proto3" json:"goodsIndex,omitempty"`   //商品索引
+	Expend      []*ItemData `protobuf:"bytes,2,rep,name=expend,proto3" json:"expend,omitempty"`            //当前消耗
+	Discount    int32       `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`       //当前折扣(百分比),0表示不打折
+	SaleEndTime int64       `protobuf:"varint,4,opt,name=saleEndTime,proto3" json:"saleEndTime,omitempty"` //限时折扣结束时间(s),0表示不在限时折扣中
+	RemainStock int32       `protobuf:"varint,5,opt,name=remainStock,proto3" json:"remainStock,omitempty"` //全服剩余库存,ExchangeGoods.Stock为0时不限库存
+}
+
+func (x *OperateGoodsPrice) Reset() {
+	*x = OperateGoodsPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_global_operate_activity_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperateGoodsPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateGoodsPrice) ProtoMessage() {}
+
+func (x *OperateGoodsPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_global_operate_activity_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateGoodsPrice.ProtoReflect.Descriptor instead.
+func (*OperateGoodsPrice) Descriptor() ([]byte, []int) {
+	return file_global_operate_activity_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *OperateGoodsPrice) GetGoodsIndex() int32 {
+	if x != nil {
+		return x.GoodsIndex
+	}
+	return 0
+}
+
+func (x *OperateGoodsPrice) GetExpend() []*ItemData {
+	if x != nil {
+		return x.Expend
+	}
+	return nil
+}
+
+func (x *OperateGoodsPrice) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *OperateGoodsPrice) GetSaleEndTime() int64 {
+	if x != nil {
+		return x.SaleEndTime
+	}
+	return 0
+}
+
+func (x *OperateGoodsPrice) GetRemainStock() int32 {
+	if x != nil {
+		return x.RemainStock
+	}
+	return 0
+}
+
+var File_global_operate_activity_proto protoreflect.FileDescriptor
+
+var file_global_operate_activity_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x04, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x2c, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x4e, 0x75, 0x6d, 0x22, 0x5d,
+	0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x72, 0x6c, 0x22, 0xf1, 0x08,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x30, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x10, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6d, 0x67,
+	0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x49, 0x6d, 0x67, 0x55,
+	0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x73,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49, 0x73, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x35, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x49,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x49,
+	0x12, 0x12, 0x0a, 0x04, 0x53, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x53, 0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x4e, 0x65, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x4e, 0x65, 0x65, 0x64, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x0b,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x65,
+	0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x0c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x05, 0x4d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x05, 0x4d, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x53, 0x0a, 0x11,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x78, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x2f, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xc6, 0x02, 0x0a, 0x0b,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x42, 0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42,
+	0x6f, 0x64, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x33, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x69, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x44,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x44, 0x61, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x54, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x54, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3e, 0x0a, 0x0c, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x24, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x62, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x0c,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa9, 0x05, 0x0a, 0x10, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12,
+	0x35, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x07, 0x4c, 0x6f, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x05, 0x47, 0x75, 0x69,
+	0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x05, 0x47,
+	0x75, 0x69, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x42,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x42, 0x61, 0x74, 0x74, 0x6c,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x05, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x77, 0x0a, 0x10, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x2d, 0x0a, 0x0a, 0x52, 0x53, 0x49, 0x5f, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x09, 0x52, 0x53, 0x49, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x34,
+	0x0a, 0x0d, 0x52, 0x53, 0x49, 0x5f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x52, 0x53, 0x49, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x53, 0x69, 0x67, 0x6e,
+	0x49, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xa8, 0x04, 0x0a, 0x0e, 0x53, 0x69, 0x67,
+	0x6e, 0x49, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x49,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x69,
+	0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49,
+	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x49, 0x6e, 0x12, 0x32, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x73, 0x41, 0x75, 0x74,
+	0x6f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x3c, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x47,
+	0x72, 0x61, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x72, 0x61, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x65, 0x63, 0x61, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x65, 0x63, 0x61,
+	0x79, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd6, 0x02,
+	0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12,
+	0x24, 0x0a, 0x05, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05,
+	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x45, 0x78, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x53, 0x61, 0x6c, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x53, 0x61, 0x6c, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0b, 0x53, 0x61, 0x6c, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x60, 0x0a, 0x0a, 0x53, 0x61, 0x6c, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x31, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x6c, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x6c, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x43, 0x6f, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x52, 0x0b, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0e, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f,
+	0x6c, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x0f, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0a, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x47, 0x75, 0x69, 0x6c,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x72, 0x0a, 0x09,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x9c, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x38, 0x0a, 0x0a, 0x4d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x4d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x54, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x26, 0x0a,
+	0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x06, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x58, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x58, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x42,
+	0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x58, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x58, 0x70, 0x12, 0x2e,
+	0x0a, 0x0a, 0x46, 0x72, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x0a, 0x46, 0x72, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34,
+	0x0a, 0x0d, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x22, 0x36, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0x3a, 0x0a, 0x09,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2d, 0x0a, 0x08, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x08,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4e, 0x65, 0x78, 0x74,
+	0x22, 0x58, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x28, 0x0a, 0x05, 0x54, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54,
+	0x69, 0x65, 0x72, 0x52, 0x05, 0x54, 0x69, 0x65, 0x72, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x22, 0x68, 0x0a,
+	0x0a, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4d, 0x69,
+	0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x6b, 0x12,
+	0x26, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x56, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x5d, 0x0a, 0x0d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x24, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x9d,
+	0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x61,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x22, 0x92,
+	0x03, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x44, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x50, 0x72, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x6f, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x44, 0x42, 0x2e, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x4d, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x47, 0x6f, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x11,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x42, 0x12, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x42, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7b,
+	0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x47, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x47, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x09, 0x54,
+	0x61, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x39, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x51, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x05,
+	0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x44, 0x42, 0x12, 0x32, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x44, 0x42,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x49, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x08,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x44, 0x42, 0x12, 0x41, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0d, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x12, 0x3b, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0b, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x42, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x44, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x44, 0x42, 0x52, 0x09, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x44, 0x42, 0x12,
+	0x32, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x42,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52,
+	0x09, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x42, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x75,
+	0x69, 0x6c, 0x64, 0x44, 0x42, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x52, 0x07, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x42, 0x12, 0x3b, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x44, 0x42, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x44, 0x42, 0x12, 0x3e, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x44, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0c, 0x42, 0x61, 0x74, 0x74,
+	0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x44, 0x42, 0x12, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x44, 0x42, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x0a, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x44, 0x42, 0x12, 0x2f, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x42, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x52, 0x07, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x44, 0x42, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x40, 0x0a, 0x07,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x9d, 0x03, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x0e, 0x0a, 0x02, 0x58, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x58, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72,
+	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x12, 0x4d, 0x0a, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x61, 0x73, 0x73, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3e,
+	0x0a, 0x10, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41,
+	0x0a, 0x13, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x31, 0x0a, 0x08, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x07,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x22, 0xe0, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x4b, 0x0a,
+	0x0b, 0x52, 0x65, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x52, 0x65, 0x61,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x52,
+	0x65, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0b, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x12, 0x48, 0x0a, 0x09, 0x42, 0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x2e, 0x42, 0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x42, 0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x42, 0x75, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x11, 0x4c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42,
+	0x12, 0x47, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x44, 0x72,
+	0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x44,
+	0x72, 0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x47, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x47, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x72, 0x61,
+	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x03, 0x0a, 0x10, 0x53, 0x69, 0x67,
+	0x6e, 0x49, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x4c,
+	0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x47, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x42, 0x2e, 0x47, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x47, 0x6f, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x72,
+	0x79, 0x44, 0x61, 0x79, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x45, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x47, 0x6f, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x0f, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x48, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x42, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x42, 0x52, 0x08, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x6e,
+	0x66, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x64, 0x61, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x70, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x12, 0x42, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47,
+	0x6f, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x6f, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x12, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x6e, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x22, 0xb6, 0x02, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x74, 0x70, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x0b, 0x67, 0x6f, 0x6f,
+	0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x64,
+	0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x61, 0x73, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x0f, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f,
+	0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x78,
+	0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65,
+	0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x2a, 0x66, 0x0a, 0x17, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a,
+	0x0b, 0x41, 0x54, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x41, 0x42, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x2a, 0xf4, 0x01, 0x0a, 0x14, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x54, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x49, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f,
+	0x4e, 0x53, 0x55, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03,
+	0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x4f, 0x54, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x41, 0x54, 0x54,
+	0x4c, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x09, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x45, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x0b, 0x2a, 0x48, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x54, 0x52, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x54, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x0a, 0x53,
+	0x69, 0x67, 0x6e, 0x49, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x49, 0x4d,
+	0x5f, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x53, 0x49, 0x4d, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x53, 0x49, 0x4d, 0x5f, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x10, 0x02, 0x2a,
+	0x9d, 0x0c, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x45, 0x43, 0x5f, 0x50, 0x41,
+	0x52, 0x41, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4f,
+	0x45, 0x43, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x4f, 0x45, 0x43, 0x5f, 0x44, 0x42, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x4d, 0x53, 0x47, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x45, 0x43, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54,
+	0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x45, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
+	0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x07, 0x12, 0x20, 0x0a,
+	0x1c, 0x4f, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0x08, 0x12,
+	0x1a, 0x0a, 0x16, 0x4f, 0x45, 0x43, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x4f,
+	0x45, 0x43, 0x5f, 0x43, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55,
+	0x47, 0x48, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x55, 0x42, 0x5f,
+	0x43, 0x4f, 0x53, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x4f,
+	0x45, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x10, 0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x45, 0x43, 0x5f, 0x44, 0x45, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53,
+	0x48, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f,
+	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x64, 0x12,
+	0x19, 0x0a, 0x15, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x54, 0x4f, 0x44, 0x41,
+	0x59, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x45,
+	0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x10, 0x66, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x67, 0x12, 0x17, 0x0a,
+	0x13, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44,
+	0x5f, 0x47, 0x4f, 0x54, 0x10, 0x68, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45,
+	0x50, 0x41, 0x49, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x10, 0x69, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52,
+	0x5f, 0x44, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x10, 0x6a, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48,
+	0x10, 0x6b, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x4f,
+	0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x6d, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e,
+	0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x6e, 0x12, 0x1d,
+	0x0a, 0x18, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x53,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xc8, 0x01, 0x12, 0x19, 0x0a,
+	0x14, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x53, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0xc9, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x4f, 0x45, 0x43, 0x5f,
+	0x53, 0x48, 0x4f, 0x50, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
+	0x4e, 0x4f, 0x55, 0x47, 0x48, 0x10, 0xca, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x4f, 0x45, 0x43, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xac,
+	0x02, 0x12, 0x19, 0x0a, 0x14, 0x4f, 0x45, 0x43, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xad, 0x02, 0x12, 0x18, 0x0a, 0x13,
+	0x4f, 0x45, 0x43, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58,
+	0x49, 0x53, 0x54, 0x10, 0x90, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x4f, 0x45, 0x43, 0x5f, 0x53, 0x43,
+	0x4f, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x47, 0x4f, 0x54, 0x10, 0x91,
+	0x03, 0x12, 0x1a, 0x0a, 0x15, 0x4f, 0x45, 0x43, 0x5f, 0x4c, 0x4f, 0x54, 0x54, 0x45, 0x52, 0x59,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xf4, 0x03, 0x12, 0x1b, 0x0a,
+	0x16, 0x4f, 0x45, 0x43, 0x5f, 0x4c, 0x4f, 0x54, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x4f, 0x4f,
+	0x4c, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0xf5, 0x03, 0x12, 0x19, 0x0a, 0x14, 0x4f, 0x45,
+	0x43, 0x5f, 0x47, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4a, 0x4f, 0x49, 0x4e,
+	0x45, 0x44, 0x10, 0xd8, 0x04, 0x12, 0x26, 0x0a, 0x21, 0x4f, 0x45, 0x43, 0x5f, 0x47, 0x55, 0x49,
+	0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55, 0x47, 0x48, 0x10, 0xd9, 0x04, 0x12, 0x1c, 0x0a,
+	0x17, 0x4f, 0x45, 0x43, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xbc, 0x05, 0x12, 0x1e, 0x0a, 0x19, 0x4f,
+	0x45, 0x43, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xbd, 0x05, 0x12, 0x1a, 0x0a, 0x15, 0x4f,
+	0x45, 0x43, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x45, 0x44, 0x10, 0xbe, 0x05, 0x12, 0x1f, 0x0a, 0x1a, 0x4f, 0x45, 0x43, 0x5f, 0x4d,
+	0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4c, 0x49,
+	0x47, 0x49, 0x42, 0x4c, 0x45, 0x10, 0xbf, 0x05, 0x12, 0x1d, 0x0a, 0x18, 0x4f, 0x45, 0x43, 0x5f,
+	0x50, 0x41, 0x53, 0x53, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x10, 0xa0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x4f, 0x45, 0x43, 0x5f, 0x50,
+	0x41, 0x53, 0x53, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0xa1, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x4f, 0x45, 0x43, 0x5f,
+	0x50, 0x41, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x49,
+	0x4d, 0x45, 0x44, 0x10, 0xa2, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x4f, 0x45, 0x43, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0xa3, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x4f, 0x45, 0x43, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x5f, 0x50, 0x55, 0x52, 0x43, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x10, 0xa4, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x48, 0x41,
+	0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x10, 0x84, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x43,
+	0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x85, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x4f, 0x45, 0x43,
+	0x5f, 0x52, 0x45, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x86, 0x07, 0x12, 0x21, 0x0a, 0x1c, 0x4f, 0x45, 0x43,
+	0x5f, 0x52, 0x45, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x87, 0x07, 0x12, 0x1b, 0x0a, 0x16,
+	0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x88, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x4f, 0x45, 0x43,
+	0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0xe8, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x4f, 0x45, 0x43,
+	0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0xe9, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x4f, 0x45, 0x43, 0x5f, 0x52,
+	0x45, 0x44, 0x45, 0x45, 0x4d, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f,
+	0x55, 0x50, 0x10, 0xea, 0x07, 0x12, 0x1c, 0x0a, 0x17, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x44,
+	0x45, 0x45, 0x4d, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x4f, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x44, 0x45, 0x45,
+	0x4d, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0xec, 0x07, 0x2a,
+	0x60, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4d, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x4d, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
+	0x54, 0x59, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x4d, 0x52, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x4f, 0x4d, 0x52, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x10,
+	0x03, 0x2a, 0x6b, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x4d,
+	0x53, 0x54, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x4f, 0x4d, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4f, 0x4d, 0x53, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
+	0x4f, 0x4d, 0x53, 0x54, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x4d, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x04, 0x2a, 0x3b,
+	0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x44, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x44, 0x54, 0x5f, 0x46, 0x49,
+	0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x2a, 0x57, 0x0a, 0x0f, 0x50,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x50, 0x43, 0x4e, 0x5f, 0x4c, 0x45, 0x41, 0x46, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x43, 0x4e, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x43, 0x4e,
+	0x5f, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x43, 0x4e, 0x5f, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41,
+	0x53, 0x54, 0x10, 0x04, 0x2a, 0x36, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x54, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x54, 0x54, 0x5f,
+	0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x54, 0x54, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x10,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x54, 0x53, 0x5f, 0x44, 0x6f, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4f, 0x54, 0x53, 0x5f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x4f, 0x54, 0x53, 0x5f, 0x4f, 0x76, 0x65, 0x72, 0x10, 0x02, 0x42, 0x06, 0x5a,
+	0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_global_operate_activity_proto_rawDescOnce sync.Once
+	file_global_operate_activity_proto_rawDescData = file_global_operate_activity_proto_rawDesc
+)
+
+func file_global_operate_activity_proto_rawDescGZIP() []byte {
 	file_global_operate_activity_proto_rawDescOnce.Do(func() {
 		file_global_operate_activity_proto_rawDescData = protoimpl.X.CompressGZIP(file_global_operate_activity_proto_rawDescData)
 	})
 	return file_global_operate_activity_proto_rawDescData
 }
 
-var file_global_operate_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_global_operate_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_global_operate_activity_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_global_operate_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_global_operate_activity_proto_goTypes = []interface{}{
 	(OperateActivityTimeType)(0),  // 0: Game.OperateActivityTimeType
 	(ActivityTemplateType)(0),     // 1: Game.ActivityTemplateType
 	(TaskRefreshType)(0),          // 2: Game.TaskRefreshType
-	(OperateTaskState)(0),         // 3: Game.OperateTaskState
-	(*ItemData)(nil),              // 4: Game.ItemData
-	(*ActivityImage)(nil),         // 5: Game.ActivityImage
-	(*OperateActivity)(nil),       // 6: Game.OperateActivity
-	(*ConditionGroup)(nil),        // 7: Game.ConditionGroup
-	(*ActivityList)(nil),          // 8: Game.ActivityList
-	(*ActivityTemplate)(nil),      // 9: Game.ActivityTemplate
-	(*Condition)(nil),             // 10: Game.Condition
-	(*RepairSignInRule)(nil),      // 11: Game.RepairSignInRule
-	(*SignInReward)(nil),          // 12: Game.SignInReward
-	(*SignInTemplate)(nil),        // 13: Game.SignInTemplate
-	(*ConditionTemplate)(nil),     // 14: Game.ConditionTemplate
-	(*ExchangeGoods)(nil),         // 15: Game.ExchangeGoods
-	(*ConsumptionTemplate)(nil),   // 16: Game.ConsumptionTemplate
-	(*Lottery)(nil),               // 17: Game.Lottery
-	(*LotteryTemplate)(nil),       // 18: Game.LotteryTemplate
-	(*RewardPool)(nil),            // 19: Game.RewardPool
-	(*ScoreTemplate)(nil),         // 20: Game.ScoreTemplate
-	(*OperateTaskInfo)(nil),       // 21: Game.OperateTaskInfo
-	(*OperateActivityDB)(nil),     // 22: Game.OperateActivityDB
-	(*TaskGroup)(nil),             // 23: Game.TaskGroup
-	(*ActivityDBList)(nil),        // 24: Game.ActivityDBList
-	(*ActivityTemplateDB)(nil),    // 25: Game.ActivityTemplateDB
-	(*ConsumptionTemplateDB)(nil), // 26: Game.ConsumptionTemplateDB
-	(*SignInTemplateDB)(nil),      // 27: Game.SignInTemplateDB
-	(*RepairCondition)(nil),       // 28: Game.RepairCondition
-	(*ConditionTemplateDB)(nil),   // 29: Game.ConditionTemplateDB
-	(*Operate)(nil),               // 30: Game.Operate
-	nil,                           // 31: Game.OperateActivity.ActivityListEntry
-	nil,                           // 32: Game.OperateActivityDB.GotScoresEntry
-	nil,                           // 33: Game.OperateActivityDB.ActivityListEntry
-	nil,                           // 34: Game.ActivityDBList.ListEntry
-	nil,                           // 35: Game.ConsumptionTemplateDB.BuyCountsEntry
-	nil,                           // 36: Game.SignInTemplateDB.GotsEntry
+	(SignInMode)(0),               // 3: Game.SignInMode
+	(OperateErrorCode)(0),         // 4: Game.OperateErrorCode
+	(OperateMailReason)(0),        // 5: Game.OperateMailReason
+	(OperateMailSourceType)(0),    // 6: Game.OperateMailSourceType
+	(ActivityDependType)(0),       // 7: Game.ActivityDependType
+	(PreCondNodeType)(0),          // 8: Game.PreCondNodeType
+	(RechargeTierType)(0),         // 9: Game.RechargeTierType
+	(OperateTaskState)(0),         // 10: Game.OperateTaskState
+	(*ItemData)(nil),              // 11: Game.ItemData
+	(*ActivityImage)(nil),         // 12: Game.ActivityImage
+	(*OperateActivity)(nil),       // 13: Game.OperateActivity
+	(*OperateMailConf)(nil),       // 14: Game.OperateMailConf
+	(*OperateMail)(nil),           // 15: Game.OperateMail
+	(*OperateMailSource)(nil),     // 16: Game.OperateMailSource
+	(*ActivityDependency)(nil),    // 17: Game.ActivityDependency
+	(*PreCondNode)(nil),           // 18: Game.PreCondNode
+	(*ConditionGroup)(nil),        // 19: Game.ConditionGroup
+	(*ActivityList)(nil),          // 20: Game.ActivityList
+	(*ActivityTemplate)(nil),      // 21: Game.ActivityTemplate
+	(*Condition)(nil),             // 22: Game.Condition
+	(*RepairSignInRule)(nil),      // 23: Game.RepairSignInRule
+	(*SignInReward)(nil),          // 24: Game.SignInReward
+	(*SignInTemplate)(nil),        // 25: Game.SignInTemplate
+	(*ConditionTemplate)(nil),     // 26: Game.ConditionTemplate
+	(*ExchangeGoods)(nil),         // 27: Game.ExchangeGoods
+	(*SaleWindow)(nil),            // 28: Game.SaleWindow
+	(*ConsumptionTemplate)(nil),   // 29: Game.ConsumptionTemplate
+	(*Lottery)(nil),               // 30: Game.Lottery
+	(*LotteryTemplate)(nil),       // 31: Game.LotteryTemplate
+	(*RankingTemplate)(nil),       // 32: Game.RankingTemplate
+	(*GuildTemplate)(nil),         // 33: Game.GuildTemplate
+	(*GuildTask)(nil),             // 34: Game.GuildTask
+	(*CommunityTemplate)(nil),     // 35: Game.CommunityTemplate
+	(*CommunityMilestone)(nil),    // 36: Game.CommunityMilestone
+	(*BattlePassTemplate)(nil),    // 37: Game.BattlePassTemplate
+	(*BattlePassTask)(nil),        // 38: Game.BattlePassTask
+	(*BattlePassLevel)(nil),       // 39: Game.BattlePassLevel
+	(*ChainTemplate)(nil),         // 40: Game.ChainTemplate
+	(*ChainStep)(nil),             // 41: Game.ChainStep
+	(*ChainBranch)(nil),           // 42: Game.ChainBranch
+	(*RechargeTemplate)(nil),      // 43: Game.RechargeTemplate
+	(*RechargeTier)(nil),          // 44: Game.RechargeTier
+	(*RankReward)(nil),            // 45: Game.RankReward
+	(*RewardPool)(nil),            // 46: Game.RewardPool
+	(*ScoreTemplate)(nil),         // 47: Game.ScoreTemplate
+	(*OperateTaskInfo)(nil),       // 48: Game.OperateTaskInfo
+	(*OperateActivityDB)(nil),     // 49: Game.OperateActivityDB
+	(*OperatePlayerDB)(nil),       // 50: Game.OperatePlayerDB
+	(*OperateActivityRecord)(nil), // 51: Game.OperateActivityRecord
+	(*TaskGroup)(nil),             // 52: Game.TaskGroup
+	(*ActivityDBList)(nil),        // 53: Game.ActivityDBList
+	(*ActivityTemplateDB)(nil),    // 54: Game.ActivityTemplateDB
+	(*GuildTemplateDB)(nil),       // 55: Game.GuildTemplateDB
+	(*CommunityTemplateDB)(nil),   // 56: Game.CommunityTemplateDB
+	(*BattlePassTemplateDB)(nil),  // 57: Game.BattlePassTemplateDB
+	(*ChainTemplateDB)(nil),       // 58: Game.ChainTemplateDB
+	(*ChainRecord)(nil),           // 59: Game.ChainRecord
+	(*RechargeTemplateDB)(nil),    // 60: Game.RechargeTemplateDB
+	(*RankingTemplateDB)(nil),     // 61: Game.RankingTemplateDB
+	(*ConsumptionTemplateDB)(nil), // 62: Game.ConsumptionTemplateDB
+	(*LotteryTemplateDB)(nil),     // 63: Game.LotteryTemplateDB
+	(*SignInTemplateDB)(nil),      // 64: Game.SignInTemplateDB
+	(*RepairCondition)(nil),       // 65: Game.RepairCondition
+	(*ConditionTemplateDB)(nil),   // 66: Game.ConditionTemplateDB
+	(*Operate)(nil),               // 67: Game.Operate
+	(*OperateCommunityGoal)(nil),  // 68: Game.OperateCommunityGoal
+	(*OperateMilestone)(nil),      // 69: Game.OperateMilestone
+	(*OperatePreCondNode)(nil),    // 70: Game.OperatePreCondNode
+	(*OperateTemplateClient)(nil), // 71: Game.OperateTemplateClient
+	(*OperateGuildTask)(nil),      // 72: Game.OperateGuildTask
+	(*OperateRankItem)(nil),       // 73: Game.OperateRankItem
+	(*OperateGoodsPrice)(nil),     // 74: Game.OperateGoodsPrice
+	nil,                           // 75: Game.OperateActivity.ActivityListEntry
+	nil,                           // 76: Game.OperateActivityDB.GotScoresEntry
+	nil,                           // 77: Game.OperateActivityDB.ActivityListEntry
+	nil,                           // 78: Game.OperatePlayerDB.RecordsEntry
+	nil,                           // 79: Game.ActivityDBList.ListEntry
+	nil,                           // 80: Game.GuildTemplateDB.ClaimedEntry
+	nil,                           // 81: Game.CommunityTemplateDB.ClaimedEntry
+	nil,                           // 82: Game.BattlePassTemplateDB.FreeClaimedEntry
+	nil,                           // 83: Game.BattlePassTemplateDB.PremiumClaimedEntry
+	nil,                           // 84: Game.RechargeTemplateDB.ReachCountsEntry
+	nil,                           // 85: Game.RechargeTemplateDB.ClaimCountsEntry
+	nil,                           // 86: Game.ConsumptionTemplateDB.BuyCountsEntry
+	nil,                           // 87: Game.LotteryTemplateDB.DrawCountsEntry
+	nil,                           // 88: Game.SignInTemplateDB.GotsEntry
+	(*anypb.Any)(nil),             // 89: google.protobuf.Any
 }
 var file_global_operate_activity_proto_depIdxs = []int32{
-	0,  // 0: Game.OperateActivity.TimeType:type_name -> Game.OperateActivityTimeType
-	5,  // 1: Game.OperateActivity.BackgroundImgUrl:type_name -> Game.ActivityImage
-	5,  // 2: Game.OperateActivity.TitleImgUrl:type_name -> Game.ActivityImage
-	31, // 3: Game.OperateActivity.ActivityList:type_name -> Game.OperateActivity.ActivityListEntry
-	7,  // 4: Game.OperateActivity.PreConditionGroup:type_name -> Game.ConditionGroup
-	20, // 5: Game.OperateActivity.ScoreSystem:type_name -> Game.ScoreTemplate
-	10, // 6: Game.ConditionGroup.PreCondition:type_name -> Game.Condition
-	9,  // 7: Game.ActivityList.List:type_name -> Game.ActivityTemplate
-	1,  // 8: Game.ActivityTemplate.TemplateType:type_name -> Game.ActivityTemplateType
-	13, // 9: Game.ActivityTemplate.SignIn:type_name -> Game.SignInTemplate
-	14, // 10: Game.ActivityTemplate.Condition:type_name -> Game.ConditionTemplate
-	16, // 11: Game.ActivityTemplate.Consumption:type_name -> Game.ConsumptionTemplate
-	18, // 12: Game.ActivityTemplate.Lottery:type_name -> Game.LotteryTemplate
-	4,  // 13: Game.Condition.RewardList:type_name -> Game.ItemData
-	2,  // 14: Game.Condition.RefreshType:type_name -> Game.TaskRefreshType
-	4,  // 15: Game.RepairSignInRule.RSI_Expend:type_name -> Game.ItemData
-	10, // 16: Game.RepairSignInRule.RSI_Condition:type_name -> Game.Condition
-	4,  // 17: Game.SignInReward.SignInReward:type_name -> Game.ItemData
-	11, // 18: Game.SignInTemplate.RepairSignIn:type_name -> Game.RepairSignInRule
-	12, // 19: Game.SignInTemplate.RewardList:type_name -> Game.SignInReward
-	10, // 20: Game.ConditionTemplate.data:type_name -> Game.Condition
-	4,  // 21: Game.ExchangeGoods.Goods:type_name -> Game.ItemData
-	4,  // 22: Game.ExchangeGoods.Expend:type_name -> Game.ItemData
-	15, // 23: Game.ConsumptionTemplate.SellGoods:type_name -> Game.ExchangeGoods
-	4,  // 24: Game.LotteryTemplate.TargetGoods:type_name -> Game.ItemData
-	17, // 25: Game.LotteryTemplate.LotteryList:type_name -> Game.Lottery
-	4,  // 26: Game.LotteryTemplate.GuaranteedItem:type_name -> Game.ItemData
-	4,  // 27: Game.RewardPool.Reward:type_name -> Game.ItemData
-	4,  // 28: Game.ScoreTemplate.score:type_name -> Game.ItemData
-	4,  // 29: Game.ScoreTemplate.Reward:type_name -> Game.ItemData
-	3,  // 30: Game.OperateTaskInfo.taskState:type_name -> Game.OperateTaskState
-	23, // 31: Game.OperateActivityDB.PreTaskGroup:type_name -> Game.TaskGroup
-	32, // 32: Game.OperateActivityDB.GotScores:type_name -> Game.OperateActivityDB.GotScoresEntry
-	33, // 33: Game.OperateActivityDB.ActivityList:type_name -> Game.OperateActivityDB.ActivityListEntry
-	21, // 34: Game.TaskGroup.PreTaskInfos:type_name -> Game.OperateTaskInfo
-	34, // 35: Game.ActivityDBList.List:type_name -> Game.ActivityDBList.ListEntry
-	27, // 36: Game.ActivityTemplateDB.SignInDB:type_name -> Game.SignInTemplateDB
-	26, // 37: Game.ActivityTemplateDB.ConsumptionDB:type_name -> Game.ConsumptionTemplateDB
-	29, // 38: Game.ActivityTemplateDB.ConditionDB:type_name -> Game.ConditionTemplateDB
-	35, // 39: Game.ConsumptionTemplateDB.BuyCounts:type_name -> Game.ConsumptionTemplateDB.BuyCountsEntry
-	28, // 40: Game.SignInTemplateDB.conditions:type_name -> Game.RepairCondition
-	36, // 41: Game.SignInTemplateDB.Gots:type_name -> Game.SignInTemplateDB.GotsEntry
-	21, // 42: Game.RepairCondition.tasks:type_name -> Game.OperateTaskInfo
-	21, // 43: Game.ConditionTemplateDB.taskInfo:type_name -> Game.OperateTaskInfo
-	22, // 44: Game.Operate.detailed:type_name -> Game.OperateActivityDB
-	6,  // 45: Game.Operate.conf:type_name -> Game.OperateActivity
-	8,  // 46: Game.OperateActivity.ActivityListEntry.value:type_name -> Game.ActivityList
-	24, // 47: Game.OperateActivityDB.ActivityListEntry.value:type_name -> Game.ActivityDBList
-	25, // 48: Game.ActivityDBList.ListEntry.value:type_name -> Game.ActivityTemplateDB
-	49, // [49:49] is the sub-list for method output_type
-	49, // [49:49] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	0,   // 0: Game.OperateActivity.TimeType:type_name -> Game.OperateActivityTimeType
+	12,  // 1: Game.OperateActivity.BackgroundImgUrl:type_name -> Game.ActivityImage
+	12,  // 2: Game.OperateActivity.TitleImgUrl:type_name -> Game.ActivityImage
+	75,  // 3: Game.OperateActivity.ActivityList:type_name -> Game.OperateActivity.ActivityListEntry
+	19,  // 4: Game.OperateActivity.PreConditionGroup:type_name -> Game.ConditionGroup
+	47,  // 5: Game.OperateActivity.ScoreSystem:type_name -> Game.ScoreTemplate
+	18,  // 6: Game.OperateActivity.PreCondTree:type_name -> Game.PreCondNode
+	17,  // 7: Game.OperateActivity.Dependencies:type_name -> Game.ActivityDependency
+	14,  // 8: Game.OperateActivity.Mails:type_name -> Game.OperateMailConf
+	5,   // 9: Game.OperateMailConf.Reason:type_name -> Game.OperateMailReason
+	5,   // 10: Game.OperateMail.Reason:type_name -> Game.OperateMailReason
+	16,  // 11: Game.OperateMail.Sources:type_name -> Game.OperateMailSource
+	11,  // 12: Game.OperateMail.Items:type_name -> Game.ItemData
+	2,   // 13: Game.OperateMail.ResetType:type_name -> Game.TaskRefreshType
+	6,   // 14: Game.OperateMailSource.Type:type_name -> Game.OperateMailSourceType
+	1,   // 15: Game.OperateMailSource.TemplateType:type_name -> Game.ActivityTemplateType
+	11,  // 16: Game.OperateMailSource.Items:type_name -> Game.ItemData
+	7,   // 17: Game.ActivityDependency.Type:type_name -> Game.ActivityDependType
+	8,   // 18: Game.PreCondNode.Type:type_name -> Game.PreCondNodeType
+	18,  // 19: Game.PreCondNode.Children:type_name -> Game.PreCondNode
+	22,  // 20: Game.ConditionGroup.PreCondition:type_name -> Game.Condition
+	21,  // 21: Game.ActivityList.List:type_name -> Game.ActivityTemplate
+	1,   // 22: Game.ActivityTemplate.TemplateType:type_name -> Game.ActivityTemplateType
+	25,  // 23: Game.ActivityTemplate.SignIn:type_name -> Game.SignInTemplate
+	26,  // 24: Game.ActivityTemplate.Condition:type_name -> Game.ConditionTemplate
+	29,  // 25: Game.ActivityTemplate.Consumption:type_name -> Game.ConsumptionTemplate
+	31,  // 26: Game.ActivityTemplate.Lottery:type_name -> Game.LotteryTemplate
+	89,  // 27: Game.ActivityTemplate.Extension:type_name -> google.protobuf.Any
+	32,  // 28: Game.ActivityTemplate.Ranking:type_name -> Game.RankingTemplate
+	33,  // 29: Game.ActivityTemplate.Guild:type_name -> Game.GuildTemplate
+	35,  // 30: Game.ActivityTemplate.Community:type_name -> Game.CommunityTemplate
+	37,  // 31: Game.ActivityTemplate.BattlePass:type_name -> Game.BattlePassTemplate
+	43,  // 32: Game.ActivityTemplate.Recharge:type_name -> Game.RechargeTemplate
+	40,  // 33: Game.ActivityTemplate.Chain:type_name -> Game.ChainTemplate
+	11,  // 34: Game.Condition.RewardList:type_name -> Game.ItemData
+	2,   // 35: Game.Condition.RefreshType:type_name -> Game.TaskRefreshType
+	11,  // 36: Game.RepairSignInRule.RSI_Expend:type_name -> Game.ItemData
+	22,  // 37: Game.RepairSignInRule.RSI_Condition:type_name -> Game.Condition
+	11,  // 38: Game.SignInReward.SignInReward:type_name -> Game.ItemData
+	23,  // 39: Game.SignInTemplate.RepairSignIn:type_name -> Game.RepairSignInRule
+	24,  // 40: Game.SignInTemplate.RewardList:type_name -> Game.SignInReward
+	3,   // 41: Game.SignInTemplate.Mode:type_name -> Game.SignInMode
+	22,  // 42: Game.ConditionTemplate.data:type_name -> Game.Condition
+	11,  // 43: Game.ExchangeGoods.Goods:type_name -> Game.ItemData
+	11,  // 44: Game.ExchangeGoods.Expend:type_name -> Game.ItemData
+	28,  // 45: Game.ExchangeGoods.SaleWindows:type_name -> Game.SaleWindow
+	2,   // 46: Game.ExchangeGoods.RefreshType:type_name -> Game.TaskRefreshType
+	27,  // 47: Game.ConsumptionTemplate.SellGoods:type_name -> Game.ExchangeGoods
+	11,  // 48: Game.LotteryTemplate.TargetGoods:type_name -> Game.ItemData
+	30,  // 49: Game.LotteryTemplate.LotteryList:type_name -> Game.Lottery
+	11,  // 50: Game.LotteryTemplate.GuaranteedItem:type_name -> Game.ItemData
+	45,  // 51: Game.RankingTemplate.RewardList:type_name -> Game.RankReward
+	34,  // 52: Game.GuildTemplate.Tasks:type_name -> Game.GuildTask
+	22,  // 53: Game.GuildTask.Task:type_name -> Game.Condition
+	22,  // 54: Game.CommunityTemplate.Task:type_name -> Game.Condition
+	36,  // 55: Game.CommunityTemplate.Milestones:type_name -> Game.CommunityMilestone
+	11,  // 56: Game.CommunityMilestone.Reward:type_name -> Game.ItemData
+	38,  // 57: Game.BattlePassTemplate.Tasks:type_name -> Game.BattlePassTask
+	39,  // 58: Game.BattlePassTemplate.Levels:type_name -> Game.BattlePassLevel
+	22,  // 59: Game.BattlePassTask.Task:type_name -> Game.Condition
+	11,  // 60: Game.BattlePassLevel.FreeReward:type_name -> Game.ItemData
+	11,  // 61: Game.BattlePassLevel.PremiumReward:type_name -> Game.ItemData
+	41,  // 62: Game.ChainTemplate.Steps:type_name -> Game.ChainStep
+	42,  // 63: Game.ChainStep.Branches:type_name -> Game.ChainBranch
+	22,  // 64: Game.ChainBranch.Task:type_name -> Game.Condition
+	44,  // 65: Game.RechargeTemplate.Tiers:type_name -> Game.RechargeTier
+	9,   // 66: Game.RechargeTier.Type:type_name -> Game.RechargeTierType
+	11,  // 67: Game.RechargeTier.Reward:type_name -> Game.ItemData
+	11,  // 68: Game.RankReward.Reward:type_name -> Game.ItemData
+	11,  // 69: Game.RewardPool.Reward:type_name -> Game.ItemData
+	11,  // 70: Game.ScoreTemplate.score:type_name -> Game.ItemData
+	11,  // 71: Game.ScoreTemplate.Reward:type_name -> Game.ItemData
+	10,  // 72: Game.OperateTaskInfo.taskState:type_name -> Game.OperateTaskState
+	52,  // 73: Game.OperateActivityDB.PreTaskGroup:type_name -> Game.TaskGroup
+	76,  // 74: Game.OperateActivityDB.GotScores:type_name -> Game.OperateActivityDB.GotScoresEntry
+	77,  // 75: Game.OperateActivityDB.ActivityList:type_name -> Game.OperateActivityDB.ActivityListEntry
+	78,  // 76: Game.OperatePlayerDB.Records:type_name -> Game.OperatePlayerDB.RecordsEntry
+	48,  // 77: Game.TaskGroup.PreTaskInfos:type_name -> Game.OperateTaskInfo
+	79,  // 78: Game.ActivityDBList.List:type_name -> Game.ActivityDBList.ListEntry
+	64,  // 79: Game.ActivityTemplateDB.SignInDB:type_name -> Game.SignInTemplateDB
+	62,  // 80: Game.ActivityTemplateDB.ConsumptionDB:type_name -> Game.ConsumptionTemplateDB
+	66,  // 81: Game.ActivityTemplateDB.ConditionDB:type_name -> Game.ConditionTemplateDB
+	63,  // 82: Game.ActivityTemplateDB.LotteryDB:type_name -> Game.LotteryTemplateDB
+	89,  // 83: Game.ActivityTemplateDB.Extension:type_name -> google.protobuf.Any
+	61,  // 84: Game.ActivityTemplateDB.RankingDB:type_name -> Game.RankingTemplateDB
+	55,  // 85: Game.ActivityTemplateDB.GuildDB:type_name -> Game.GuildTemplateDB
+	56,  // 86: Game.ActivityTemplateDB.CommunityDB:type_name -> Game.CommunityTemplateDB
+	57,  // 87: Game.ActivityTemplateDB.BattlePassDB:type_name -> Game.BattlePassTemplateDB
+	60,  // 88: Game.ActivityTemplateDB.RechargeDB:type_name -> Game.RechargeTemplateDB
+	58,  // 89: Game.ActivityTemplateDB.ChainDB:type_name -> Game.ChainTemplateDB
+	80,  // 90: Game.GuildTemplateDB.Claimed:type_name -> Game.GuildTemplateDB.ClaimedEntry
+	48,  // 91: Game.GuildTemplateDB.TaskInfo:type_name -> Game.OperateTaskInfo
+	81,  // 92: Game.CommunityTemplateDB.Claimed:type_name -> Game.CommunityTemplateDB.ClaimedEntry
+	48,  // 93: Game.CommunityTemplateDB.TaskInfo:type_name -> Game.OperateTaskInfo
+	82,  // 94: Game.BattlePassTemplateDB.FreeClaimed:type_name -> Game.BattlePassTemplateDB.FreeClaimedEntry
+	83,  // 95: Game.BattlePassTemplateDB.PremiumClaimed:type_name -> Game.BattlePassTemplateDB.PremiumClaimedEntry
+	48,  // 96: Game.BattlePassTemplateDB.TaskInfo:type_name -> Game.OperateTaskInfo
+	48,  // 97: Game.ChainTemplateDB.TaskInfo:type_name -> Game.OperateTaskInfo
+	59,  // 98: Game.ChainTemplateDB.History:type_name -> Game.ChainRecord
+	84,  // 99: Game.RechargeTemplateDB.ReachCounts:type_name -> Game.RechargeTemplateDB.ReachCountsEntry
+	85,  // 100: Game.RechargeTemplateDB.ClaimCounts:type_name -> Game.RechargeTemplateDB.ClaimCountsEntry
+	86,  // 101: Game.ConsumptionTemplateDB.BuyCounts:type_name -> Game.ConsumptionTemplateDB.BuyCountsEntry
+	87,  // 102: Game.LotteryTemplateDB.DrawCounts:type_name -> Game.LotteryTemplateDB.DrawCountsEntry
+	65,  // 103: Game.SignInTemplateDB.conditions:type_name -> Game.RepairCondition
+	88,  // 104: Game.SignInTemplateDB.Gots:type_name -> Game.SignInTemplateDB.GotsEntry
+	48,  // 105: Game.RepairCondition.tasks:type_name -> Game.OperateTaskInfo
+	48,  // 106: Game.ConditionTemplateDB.taskInfo:type_name -> Game.OperateTaskInfo
+	49,  // 107: Game.Operate.detailed:type_name -> Game.OperateActivityDB
+	13,  // 108: Game.Operate.conf:type_name -> Game.OperateActivity
+	71,  // 109: Game.Operate.templates:type_name -> Game.OperateTemplateClient
+	70,  // 110: Game.Operate.preCond:type_name -> Game.OperatePreCondNode
+	68,  // 111: Game.Operate.communityGoals:type_name -> Game.OperateCommunityGoal
+	69,  // 112: Game.OperateCommunityGoal.milestones:type_name -> Game.OperateMilestone
+	8,   // 113: Game.OperatePreCondNode.type:type_name -> Game.PreCondNodeType
+	70,  // 114: Game.OperatePreCondNode.children:type_name -> Game.OperatePreCondNode
+	74,  // 115: Game.OperateTemplateClient.goodsPrices:type_name -> Game.OperateGoodsPrice
+	72,  // 116: Game.OperateTemplateClient.guildTasks:type_name -> Game.OperateGuildTask
+	10,  // 117: Game.OperateGuildTask.taskState:type_name -> Game.OperateTaskState
+	11,  // 118: Game.OperateGoodsPrice.expend:type_name -> Game.ItemData
+	20,  // 119: Game.OperateActivity.ActivityListEntry.value:type_name -> Game.ActivityList
+	53,  // 120: Game.OperateActivityDB.ActivityListEntry.value:type_name -> Game.ActivityDBList
+	51,  // 121: Game.OperatePlayerDB.RecordsEntry.value:type_name -> Game.OperateActivityRecord
+	54,  // 122: Game.ActivityDBList.ListEntry.value:type_name -> Game.ActivityTemplateDB
+	123, // [123:123] is the sub-list for method output_type
+	123, // [123:123] is the sub-list for method input_type
+	123, // [123:123] is the sub-list for extension type_name
+	123, // [123:123] is the sub-list for extension extendee
+	0,   // [0:123] is the sub-list for field type_name
 }
 
 func init() { file_global_operate_activity_proto_init() }
@@ -2575,8 +6562,212 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityImage); i {
+		file_global_operate_activity_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateMailConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateMail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateMailSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreCondNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairSignInRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeGoods); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaleWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumptionTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2587,8 +6778,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperateActivity); i {
+		file_global_operate_activity_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lottery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2599,8 +6790,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConditionGroup); i {
+		file_global_operate_activity_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2611,8 +6802,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityList); i {
+		file_global_operate_activity_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankingTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2623,8 +6814,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityTemplate); i {
+		file_global_operate_activity_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2635,8 +6826,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Condition); i {
+		file_global_operate_activity_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2647,8 +6838,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepairSignInRule); i {
+		file_global_operate_activity_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2659,8 +6850,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInReward); i {
+		file_global_operate_activity_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityMilestone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,8 +6862,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInTemplate); i {
+		file_global_operate_activity_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BattlePassTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2683,8 +6874,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConditionTemplate); i {
+		file_global_operate_activity_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BattlePassTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2695,8 +6886,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeGoods); i {
+		file_global_operate_activity_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BattlePassLevel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2707,8 +6898,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsumptionTemplate); i {
+		file_global_operate_activity_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2719,8 +6910,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lottery); i {
+		file_global_operate_activity_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2731,8 +6922,8 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LotteryTemplate); i {
+		file_global_operate_activity_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainBranch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2743,7 +6934,43 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RechargeTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RechargeTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RewardPool); i {
 			case 0:
 				return &v.state
@@ -2755,7 +6982,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScoreTemplate); i {
 			case 0:
 				return &v.state
@@ -2767,7 +6994,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OperateTaskInfo); i {
 			case 0:
 				return &v.state
@@ -2779,7 +7006,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OperateActivityDB); i {
 			case 0:
 				return &v.state
@@ -2791,7 +7018,31 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePlayerDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateActivityRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskGroup); i {
 			case 0:
 				return &v.state
@@ -2803,7 +7054,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActivityDBList); i {
 			case 0:
 				return &v.state
@@ -2815,7 +7066,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActivityTemplateDB); i {
 			case 0:
 				return &v.state
@@ -2827,7 +7078,91 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuildTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunityTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BattlePassTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RechargeTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankingTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumptionTemplateDB); i {
 			case 0:
 				return &v.state
@@ -2839,7 +7174,19 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryTemplateDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignInTemplateDB); i {
 			case 0:
 				return &v.state
@@ -2851,7 +7198,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepairCondition); i {
 			case 0:
 				return &v.state
@@ -2863,7 +7210,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConditionTemplateDB); i {
 			case 0:
 				return &v.state
@@ -2875,7 +7222,7 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
-		file_global_operate_activity_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_global_operate_activity_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Operate); i {
 			case 0:
 				return &v.state
@@ -2887,14 +7234,98 @@ func file_global_operate_activity_proto_init() {
 				return nil
 			}
 		}
+		file_global_operate_activity_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateCommunityGoal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateMilestone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatePreCondNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateTemplateClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateGuildTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateRankItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_global_operate_activity_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperateGoodsPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_global_operate_activity_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   33,
+			NumEnums:      11,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,9 +12,10 @@ import (
 	"errors"
 	"github.com/dingqinghui/activity/pb"
 	"go.uber.org/zap"
+	"sort"
 )
 
-// RangeTaskFunType 触发任务遍历函数  return:true 触发任务成功
+// RangeTaskFunType 触发任务遍历函数,taskInfo为存档中的任务进度,可累加或直接设置进度  return:true 触发任务成功
 type RangeTaskFunType func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool
 
 type (
@@ -54,9 +55,9 @@ type Activity struct {
 
 	//
 	// timeTool
-	// @Description: 活动时间处理器
+	// @Description: 活动时间处理器,相对时间活动按玩家注册时间/开服时间转换为时间戳
 	//
-	//timeTool IActivityTime
+	timeTool IActivityTime
 
 	//
 	// dbData
@@ -79,11 +80,18 @@ func newActivity(dbData *pb.OperateActivityDB, mgr *PlayerActivityMgr) (*Activit
 		return nil, errors.New("db data is nil")
 	}
 	conf := GetActivity(dbData.GetActivityId())
+	if conf == nil {
+		// 活动结束时离线的玩家,关闭前使用保留的配置创建实例用于结算
+		conf = getGlobalOperateActivityMgr().getClosingActivity(dbData.GetActivityId())
+	}
 	if conf == nil {
 		return nil, errors.New("conf is nil")
 	}
 
 	timeTool := NewActivityTime(conf, mgr.getRegisterTime(), mgr.getArea())
+	if timeTool == nil {
+		return nil, errors.New("invalid time type")
+	}
 
 	// 拷贝global配置数据,转换相对时间为时间戳
 	cConf := &pb.OperateActivity{}
@@ -100,7 +108,7 @@ func newActivity(dbData *pb.OperateActivityDB, mgr *PlayerActivityMgr) (*Activit
 		templates: make(map[int32][]iTemplate),
 		dbData:    dbData,
 		conf:      conf,
-		//timeTool:  timeTool,
+		timeTool:  timeTool,
 	}
 	activity.init()
 	return activity, nil
@@ -114,7 +122,7 @@ func (m *Activity) init() {
 			isInit := dbData == nil
 
 			t := m.addTemplate(day, int32(index), tplConf, dbData)
-			if isInit {
+			if isInit && t != nil {
 				m.saveTemplateData(day, index, t.getDbData())
 			}
 		}
@@ -189,37 +197,16 @@ func (m *Activity) addTemplate(day int32, index int32, tplConf *pb.ActivityTempl
 
 //
 // finishedPreCondition
-// @Description: 前置任务是否完成
+// @Description: 前置任务是否完成,按前置条件表达式树计算
 // @receiver m
 // @return bool true:完成
 //
 func (m *Activity) finishedPreCondition() bool {
-	if m.getDbData().GetPreTaskGroup() == nil {
+	progress := m.getPreCondProgress()
+	if progress == nil {
 		return true
 	}
-	for _, group := range m.getDbData().GetPreTaskGroup() {
-		// 有一个组完成，则任务就可以生效
-		if m.finishedPreConditionGroup(group) {
-			return true
-		}
-	}
-	return false
-}
-
-//
-// finishedPreConditionGroup
-// @Description: 组内任务是否全部完成
-// @receiver m
-// @param group
-// @return bool
-//
-func (m *Activity) finishedPreConditionGroup(group *pb.TaskGroup) bool {
-	for _, taskInfo := range group.GetPreTaskInfos() {
-		if taskInfo.GetTaskState() == pb.OperateTaskState_OTS_Doing {
-			return false
-		}
-	}
-	return true
+	return progress.GetFinished()
 }
 
 //
@@ -229,12 +216,16 @@ func (m *Activity) finishedPreConditionGroup(group *pb.TaskGroup) bool {
 // @return bool true:无效
 //
 func (m *Activity) invalid() error {
+	// 依赖活动未完成
+	if err := m.mgr.checkDependencies(m.getConf()); err != nil {
+		return err
+	}
 	// 未完成前置任务
 	if !m.finishedPreCondition() {
-		return errors.New("活动前置条件未满足")
+		return preConditionNotFinish.with("activityId", m.getId())
 	}
 	if !m.isOpenTime() {
-		return errors.New("活动未到开启时间")
+		return activityNotOpen.with("activityId", m.getId())
 	}
 	return nil
 }
@@ -246,7 +237,7 @@ func (m *Activity) invalid() error {
 // @return int32
 //
 func (m *Activity) openDay() int32 {
-	return int32(diffDayNum(nowTimestamp(), m.getConf().GetStartTime())) + 1
+	return int32(diffDayNum(nowTimestamp(), m.getStartTime())) + 1
 }
 
 //
@@ -256,7 +247,27 @@ func (m *Activity) openDay() int32 {
 // @return bool true:过期
 //
 func (m *Activity) isExpire() bool {
-	return nowTimestamp() >= m.getConf().GetCloseDuration()
+	return nowTimestamp() >= m.timeTool.getCloseTime()
+}
+
+//
+// getStartTime
+// @Description: 获取玩家的活动开始时间
+// @receiver m
+// @return int64
+//
+func (m *Activity) getStartTime() int64 {
+	return m.timeTool.getStartTime()
+}
+
+//
+// getEndTime
+// @Description: 获取玩家的活动结束时间
+// @receiver m
+// @return int64
+//
+func (m *Activity) getEndTime() int64 {
+	return m.timeTool.getEndTime()
 }
 
 //
@@ -272,9 +283,15 @@ func (m *Activity) getTemplates() []iTemplate {
 		day := m.openDay()
 		return m.templates[day]
 	} else {
+		// 按天数排序,保证模板索引稳定
+		days := make([]int32, 0, len(m.templates))
+		for day := range m.templates {
+			days = append(days, day)
+		}
+		sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
 		list := make([]iTemplate, 0, 0)
-		for _, v := range m.templates {
-			list = append(list, v...)
+		for _, day := range days {
+			list = append(list, m.templates[day]...)
 		}
 		return list
 	}
@@ -289,7 +306,7 @@ func (m *Activity) getTemplates() []iTemplate {
 //
 func (m *Activity) getTemplate(index int) iTemplate {
 	templates := m.getTemplates()
-	if index >= len(templates) {
+	if index < 0 || index >= len(templates) {
 		return nil
 	}
 	return templates[index]
@@ -319,7 +336,7 @@ func (m *Activity) rangeTemplates(f func(template iTemplate)) {
 //
 func (m *Activity) isOpenTime() bool {
 	nowTime := nowTimestamp()
-	return m.getConf().GetStartTime() <= nowTime && nowTime <= m.getConf().GetEndTime()
+	return m.getStartTime() <= nowTime && nowTime <= m.getEndTime()
 }
 
 func (m *Activity) callUpdateStatusFun(updateInfo *pb.OperateActivityDB, status DataCmd) {
@@ -375,26 +392,24 @@ func (m *Activity) rangeAllCondition(f RangeTaskFunType) {
 func (m *Activity) getScoreReward(player IPlayer, index int) error {
 	conf := m.getConf()
 	scoreSystem := conf.GetScoreSystem()
-	if scoreSystem == nil {
-		return errors.New("scoreSystem is nil")
-	}
-	if index >= len(scoreSystem) {
-		return errors.New("scoreSystem index out")
+	if index < 0 || index >= len(scoreSystem) {
+		return scoreNotExist.with("activityId", m.getId()).with("scoreIndex", index)
 	}
 	scoreInfo := scoreSystem[index]
 
 	if m.isGotScoreReward(index) {
-		return errors.New("scoreSystem index got")
+		return scoreRewardGot.with("activityId", m.getId()).with("scoreIndex", index)
 	}
 
 	// 检测积分
 	if err := player.OperateCheckCost(m.getId(), []*pb.ItemData{scoreInfo.GetScore()}); err != nil {
-		return err
+		return costNotEnough.with("activityId", m.getId()).with("scoreIndex", index).wrap(err)
 	}
 
 	// 添加奖励
-	if err := player.OperateAddReward(m.getId(), scoreInfo.GetReward()); err != nil {
-		return err
+	trace := newLedgerTrace(m.getId(), LedgerSourceScoreReward, int32(index))
+	if err := m.mgr.operateAddReward(trace, scoreInfo.GetReward()); err != nil {
+		return addRewardFail.with("activityId", m.getId()).with("scoreIndex", index).wrap(err)
 	}
 
 	m.setGotScoreRewardRecord(index)
@@ -471,31 +486,174 @@ func (m *Activity) getShopTemplate(index int) *shopTemplate {
 }
 
 //
-// getCanReceiveReward
-// @Description: 获取所有可领取奖励
+// getLotteryTemplate
+// @Description: 获取抽奖模板数据
 // @receiver m
-// @return []*ItemData
+// @param index
+// @return *lotteryTemplate
 //
-func (m *Activity) getCanReceiveReward(player IPlayer) []*pb.ItemData {
-	var items []*pb.ItemData
-	m.rangeTemplates(func(template iTemplate) {
-		items = append(items, template.getCanReceiveReward()...)
-	})
-	items = append(items, m.getCanReceiveScoreReward(player)...)
-	return items
+func (m *Activity) getLotteryTemplate(index int) *lotteryTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_LOTTERY_TYPE {
+		return nil
+	}
+	return template.(*lotteryTemplate)
+}
+
+//
+// getGuildTemplate
+// @Description: 获取公会模板数据
+// @receiver m
+// @param index
+// @return *guildTemplate
+//
+func (m *Activity) getGuildTemplate(index int) *guildTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_GUILD_TYPE {
+		return nil
+	}
+	return template.(*guildTemplate)
+}
+
+//
+// getCommunityTemplate
+// @Description: 获取全服目标模板数据
+// @receiver m
+// @param index
+// @return *communityTemplate
+//
+func (m *Activity) getCommunityTemplate(index int) *communityTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_COMMUNITY_TYPE {
+		return nil
+	}
+	return template.(*communityTemplate)
+}
+
+//
+// getBattlePassTemplate
+// @Description: 获取通行证模板数据
+// @receiver m
+// @param index
+// @return *battlePassTemplate
+//
+func (m *Activity) getBattlePassTemplate(index int) *battlePassTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_BATTLE_PASS_TYPE {
+		return nil
+	}
+	return template.(*battlePassTemplate)
+}
+
+//
+// getChainTemplate
+// @Description: 获取任务链模板数据
+// @receiver m
+// @param index
+// @return *chainTemplate
+//
+func (m *Activity) getChainTemplate(index int) *chainTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_CHAIN_TYPE {
+		return nil
+	}
+	return template.(*chainTemplate)
+}
+
+//
+// getRechargeTemplate
+// @Description: 获取充值模板数据
+// @receiver m
+// @param index
+// @return *rechargeTemplate
+//
+func (m *Activity) getRechargeTemplate(index int) *rechargeTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_RECHARGE_TYPE {
+		return nil
+	}
+	return template.(*rechargeTemplate)
+}
+
+//
+// getRankingTemplate
+// @Description: 获取排行模板数据
+// @receiver m
+// @param index
+// @return *rankingTemplate
+//
+func (m *Activity) getRankingTemplate(index int) *rankingTemplate {
+	template := m.getTemplate(index)
+	if template == nil {
+		return nil
+	}
+	if template.getType() != pb.ActivityTemplateType_RANKING_TYPE {
+		return nil
+	}
+	return template.(*rankingTemplate)
+}
+
+//
+// settleRanking
+// @Description: 活动结束后结算所有排行模板,排名奖励合并为一封邮件发放,邮件发送失败时下次重试
+// @receiver m
+//
+func (m *Activity) settleRanking() {
+	player := m.mgr.getPlayer()
+	mail := newOperateMail(m, pb.OperateMailReason_OMR_RANK_SETTLE)
+	settle := m.addRankMailSources(mail, player)
+	trace := newLedgerTrace(m.getId(), LedgerSourceRankReward, 0)
+	if err := m.mgr.operateSendMail(trace, mail); err != nil {
+		return
+	}
+	settle()
 }
 
 //
-// getCanReceiveScoreReward
-// @Description: 获取可领取的积分奖励
+// sendEndMail
+// @Description: 活动结束/删除时将未结算的排名奖励和所有可领取未领取的奖励合并为一封邮件发放
+// @receiver m
+//
+func (m *Activity) sendEndMail() {
+	player := m.mgr.getPlayer()
+	mail := newOperateMail(m, pb.OperateMailReason_OMR_ACTIVITY_END)
+	settle := m.addRankMailSources(mail, player)
+	m.addRewardMailSources(mail, player)
+	trace := newLedgerTrace(m.getId(), LedgerSourceDeleteMail, 0)
+	if err := m.mgr.operateSendMail(trace, mail); err != nil {
+		return
+	}
+	settle()
+}
+
+//
+// getCanReceiveScoreIndexes
+// @Description: 获取可领取的积分奖励索引
 // @receiver m
 // @param player
-// @return []*ItemData
+// @return []int
 //
-func (m *Activity) getCanReceiveScoreReward(player IPlayer) []*pb.ItemData {
-	conf := m.getConf()
-	var items []*pb.ItemData
-	for index, scoreInfo := range conf.GetScoreSystem() {
+func (m *Activity) getCanReceiveScoreIndexes(player IPlayer) []int {
+	var indexes []int
+	for index, scoreInfo := range m.getConf().GetScoreSystem() {
 		if m.isGotScoreReward(index) {
 			continue
 		}
@@ -503,9 +661,32 @@ func (m *Activity) getCanReceiveScoreReward(player IPlayer) []*pb.ItemData {
 		if err := player.OperateCheckCost(m.getId(), []*pb.ItemData{scoreInfo.GetScore()}); err != nil {
 			continue
 		}
-		items = append(items, scoreInfo.GetReward()...)
+		indexes = append(indexes, index)
 	}
-	return items
+	return indexes
+}
+
+//
+// getNotify
+// @Description: 获取活动红点信息
+// @receiver m
+// @param player
+// @return *pb.OperateNotify
+//
+func (m *Activity) getNotify(player IPlayer) *pb.OperateNotify {
+	notify := &pb.OperateNotify{
+		ActivityId: m.getId(),
+		ScoreCount: int32(len(m.getCanReceiveScoreIndexes(player))),
+	}
+	for index, template := range m.getTemplates() {
+		tplNotify := template.getNotify(player)
+		if tplNotify == nil {
+			continue
+		}
+		tplNotify.TplIndex = int32(index)
+		notify.Templates = append(notify.Templates, tplNotify)
+	}
+	return notify
 }
 
 //
@@ -544,9 +725,25 @@ func (m *Activity) setGotScoreRewardRecord(index int) {
 }
 
 func (m *Activity) getClientData() *pb.Operate {
-	return &pb.Operate{
+	client := &pb.Operate{
 		Detailed: m.getDbData(),
 		Conf:     m.getConf(),
 		Day:      m.openDay(),
+		PreCond:  m.getPreCondProgress(),
+	}
+	for index, template := range m.getTemplates() {
+		// 全服目标进度
+		if community, ok := template.(*communityTemplate); ok {
+			goal := community.getGoal()
+			goal.TplIndex = int32(index)
+			client.CommunityGoals = append(client.CommunityGoals, goal)
+		}
+		tplClient := template.getClientData()
+		if tplClient == nil {
+			continue
+		}
+		tplClient.TplIndex = int32(index)
+		client.Templates = append(client.Templates, tplClient)
 	}
+	return client
 }
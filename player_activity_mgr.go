@@ -9,17 +9,10 @@
 package activity
 
 import (
-	"errors"
 	"github.com/dingqinghui/activity/pb"
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
-)
-
-// 错误定义
-var (
-	// activityNotExist 活动不存在
-	activityNotExist = errors.New("activity not exist")
-	// templateNotExist 模板不存在
-	templateNotExist = errors.New("template not exist")
+	"time"
 )
 
 // PlayerDataCmdFun 活动数据操作回调函数，当cmd == DataAdd时，updateInfo为活动完整DB数据，当cmd == DataUpdate，updateInfo为活动更改数据,未更改的数据赋值为nil
@@ -34,7 +27,30 @@ type IPlayer interface {
 	OperateCheckCost(activityId int64, cost []*pb.ItemData) error
 	OperateAddReward(activityId int64, items []*pb.ItemData) error
 	OperateSubCost(activityId int64, cost []*pb.ItemData) error
-	OperateSendMail(activityId int64, items []*pb.ItemData) error
+	OperateSendMail(mail *pb.OperateMail) error
+}
+
+//
+// ClientPusher
+// @Description: 客户端推送接口,活动添加/更新/删除时推送OperateNewS2C/OperateUpdateS2C/OperateDeleteS2C
+//
+type ClientPusher interface {
+	Push(playerId int32, msg proto.Message)
+}
+
+// PlayerOption 玩家活动管理器可选参数
+type PlayerOption func(*PlayerActivityMgr)
+
+//
+// WithClientPusher
+// @Description: 设置客户端推送
+// @param pusher
+// @return PlayerOption
+//
+func WithClientPusher(pusher ClientPusher) PlayerOption {
+	return func(m *PlayerActivityMgr) {
+		m.pusher = pusher
+	}
 }
 
 //
@@ -45,11 +61,11 @@ type IPlayer interface {
 // @param channel 玩家所属渠道
 // @param registerTime 玩家注册时间
 // @param changeDataCallback 玩家数据更改回调
-// @param initData
+// @param opts 可选参数
 // @return *PlayerActivityMgr
 //
 func NewPlayerActivityMgr(player IPlayer, areaId int32, channel int32, registerTime int64,
-	changeDataCallback PlayerDataCmdFun) *PlayerActivityMgr {
+	changeDataCallback PlayerDataCmdFun, opts ...PlayerOption) *PlayerActivityMgr {
 	if player == nil {
 		panic("operate player is nil")
 	}
@@ -61,7 +77,12 @@ func NewPlayerActivityMgr(player IPlayer, areaId int32, channel int32, registerT
 		changStatusCallback: changeDataCallback,
 		activityMap:         make(map[int64]*Activity),
 	}
-
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(m)
+	}
 	return m
 }
 
@@ -100,12 +121,52 @@ type PlayerActivityMgr struct {
 	// @Description: 状态变化回调函数
 	//
 	changStatusCallback PlayerDataCmdFun
+	//
+	// pusher
+	// @Description: 客户端推送
+	//
+	pusher ClientPusher
+	//
+	// requestId
+	// @Description: 下一次操作的请求Id,操作开始时转移到Operation上
+	//
+	requestId string
+	//
+	// operation
+	// @Description: 当前执行中的操作,流水从中获取请求Id
+	//
+	operation *Operation
+	//
+	// interceptors
+	// @Description: 玩家操作拦截器
+	//
+	interceptors []Interceptor
+	//
+	// record
+	// @Description: 玩家活动记录,活动删除后保留
+	//
+	record *pb.OperatePlayerDB
+	//
+	// recordCallback
+	// @Description: 玩家活动记录更改回调函数
+	//
+	recordCallback PlayerRecordFun
 }
 
 func (m *PlayerActivityMgr) InitData(initData map[int64]*pb.OperateActivityDB) {
 	m.init(initData)
 }
 
+//
+// GetPlayer
+// @Description: 获取玩家对象
+// @receiver m
+// @return IPlayer
+//
+func (m *PlayerActivityMgr) GetPlayer() IPlayer {
+	return m.player
+}
+
 func (m *PlayerActivityMgr) getPlayer() IPlayer {
 	return m.player
 }
@@ -123,12 +184,133 @@ func (m *PlayerActivityMgr) getRegisterTime() int64 {
 	return m.registerTime
 }
 
+//
+// SetRequestId
+// @Description: 设置下一次操作的请求Id,该操作产生的奖励流水携带此Id,操作结束后失效
+// @receiver m
+// @param requestId
+//
+func (m *PlayerActivityMgr) SetRequestId(requestId string) {
+	m.requestId = requestId
+}
+
+//
+// getRequestId
+// @Description: 获取当前操作的请求Id,定时器等非玩家操作产生的流水为空
+// @receiver m
+// @return string
+//
+func (m *PlayerActivityMgr) getRequestId() string {
+	if m.operation == nil {
+		return ""
+	}
+	return m.operation.RequestId
+}
+
+//
+// operateAddReward
+// @Description: 发放奖励并记录流水
+// @receiver m
+// @param trace
+// @param items
+// @return error
+//
+func (m *PlayerActivityMgr) operateAddReward(trace *ledgerTrace, items []*pb.ItemData) error {
+	if err := m.getPlayer().OperateAddReward(trace.activityId, items); err != nil {
+		return err
+	}
+	appendLedger(m.getPlayerId(), m.getRequestId(), trace, LedgerOpGrant, items)
+	return nil
+}
+
+//
+// operateSubCost
+// @Description: 扣除消耗并记录流水
+// @receiver m
+// @param trace
+// @param cost
+// @return error
+//
+func (m *PlayerActivityMgr) operateSubCost(trace *ledgerTrace, cost []*pb.ItemData) error {
+	if err := m.getPlayer().OperateSubCost(trace.activityId, cost); err != nil {
+		return err
+	}
+	appendLedger(m.getPlayerId(), m.getRequestId(), trace, LedgerOpCost, cost)
+	return nil
+}
+
+//
+// refundCost
+// @Description: 扣除消耗后奖励发放失败,通过OperateAddReward退还消耗并记录流水
+// @receiver m
+// @param trace 扣除消耗时的流水追踪信息
+// @param cost
+//
+func (m *PlayerActivityMgr) refundCost(trace *ledgerTrace, cost []*pb.ItemData) {
+	refund := *trace
+	refund.source = LedgerSourceCostRefund
+	if err := m.operateAddReward(&refund, cost); err != nil {
+		logError("退还消耗失败", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", trace.activityId),
+			zap.String("source", string(trace.source)), zap.Any("cost", cost), zap.Error(err))
+	}
+}
+
+//
+// operateSendMail
+// @Description: 发送邮件并记录流水,邮件没有奖励时不发送
+// @receiver m
+// @param trace
+// @param mail
+// @return error
+//
+func (m *PlayerActivityMgr) operateSendMail(trace *ledgerTrace, mail *pb.OperateMail) error {
+	if len(mail.GetItems()) <= 0 {
+		return nil
+	}
+	if err := m.getPlayer().OperateSendMail(mail); err != nil {
+		logError("发送活动邮件失败", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", trace.activityId), zap.Any("mail", mail), zap.Error(err))
+		return err
+	}
+	appendLedger(m.getPlayerId(), m.getRequestId(), trace, LedgerOpMail, mail.GetItems())
+	return nil
+}
+
 func (m *PlayerActivityMgr) callActivityDataCmdFun(activityId int64, updateInfo *pb.OperateActivityDB, cmd DataCmd) {
-	if m.changStatusCallback == nil {
+	if m.changStatusCallback != nil {
+		m.changStatusCallback(m.getPlayerId(), activityId, cmd, updateInfo)
+		logInfo("回调活动数据操作", zap.Int32("playerId", m.getPlayerId()), zap.Any("cmd", cmd), zap.Int64("activityId", activityId), zap.Any("updateInfo", updateInfo))
+	}
+	m.pushClient(activityId, updateInfo, cmd)
+}
+
+//
+// pushClient
+// @Description: 推送活动变更到客户端
+// @receiver m
+// @param activityId
+// @param updateInfo
+// @param cmd
+//
+func (m *PlayerActivityMgr) pushClient(activityId int64, updateInfo *pb.OperateActivityDB, cmd DataCmd) {
+	if m.pusher == nil {
+		return
+	}
+	var msg proto.Message
+	switch cmd {
+	case DataAdd:
+		s2c := m.PackOneActivity(activityId)
+		if s2c == nil {
+			return
+		}
+		msg = s2c
+	case DataUpdate:
+		msg = &pb.OperateUpdateS2C{Detailed: []*pb.OperateActivityDB{updateInfo}}
+	case DataDelete:
+		msg = &pb.OperateDeleteS2C{ActivityId: activityId}
+	default:
 		return
 	}
-	m.changStatusCallback(m.getPlayerId(), activityId, cmd, updateInfo)
-	logInfo("回调活动数据操作", zap.Int32("playerId", m.getPlayerId()), zap.Any("cmd", cmd), zap.Int64("activityId", activityId), zap.Any("updateInfo", updateInfo))
+	m.pusher.Push(m.getPlayerId(), msg)
 }
 
 func (m *PlayerActivityMgr) init(initData map[int64]*pb.OperateActivityDB) {
@@ -157,7 +339,8 @@ func (m *PlayerActivityMgr) dbBatchDelete(deleteList []*pb.OperateActivityDB) er
 			continue
 		}
 
-		_ = m.getPlayer().OperateSendMail(activity.getId(), activity.getCanReceiveReward(m.getPlayer()))
+		m.updateActivityRecord(activity)
+		activity.sendEndMail()
 
 		m.callActivityDataCmdFun(data.GetActivityId(), nil, DataDelete)
 	}
@@ -267,7 +450,9 @@ func (m *PlayerActivityMgr) Add(conf *pb.OperateActivity) bool {
 // @receiver m
 //
 func (m *PlayerActivityMgr) CheckNewAndDelete() {
+	defer observeDuration(metricCheckNewAndDelete, "检测添加新活动和删除旧活动耗时", time.Now())
 	m.checkAndAddGlobalActivity()
+	m.settleRanking()
 	m.checkDeleteActivity()
 }
 
@@ -315,6 +500,11 @@ func (m *PlayerActivityMgr) checkAddCondition(activity *pb.OperateActivity) bool
 		return false
 	}
 
+	// 依赖活动未完成
+	if err := m.checkDependencies(activity); err != nil {
+		return false
+	}
+
 	// 检测时间
 	timeTool := NewActivityTime(activity, m.getRegisterTime(), m.getArea())
 	if timeTool == nil {
@@ -335,9 +525,15 @@ func (m *PlayerActivityMgr) checkAddCondition(activity *pb.OperateActivity) bool
 // @return bool
 //
 func (m *PlayerActivityMgr) Delete(activityId int64) bool {
-	// 撤回直接删除活动
+	// 删除前记录活动完成状态
+	m.updateActivityRecord(m.getActivity(activityId))
+
+	// 撤回直接删除活动,只结算排名奖励
 	conf := GetActivity(activityId)
 	if conf == nil {
+		if activity := m.getActivity(activityId); activity != nil {
+			activity.settleRanking()
+		}
 		m.callActivityDataCmdFun(activityId, nil, DataDelete)
 		delete(m.activityMap, activityId)
 		logInfo("配置不存在删除运营活动实例", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId))
@@ -348,7 +544,7 @@ func (m *PlayerActivityMgr) Delete(activityId int64) bool {
 	if !ok {
 		return false
 	}
-	_ = m.getPlayer().OperateSendMail(activityId, activity.getCanReceiveReward(m.getPlayer()))
+	activity.sendEndMail()
 	m.callActivityDataCmdFun(activityId, nil, DataDelete)
 	delete(m.activityMap, activityId)
 	logInfo("删除运营活动实例", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId), zap.Any("Activity", activity))
@@ -405,7 +601,7 @@ func (m *PlayerActivityMgr) RangeAllOpen(f func(act *Activity)) {
 	}
 	for _, v := range m.activityMap {
 		if err := v.invalid(); err != nil {
-			return
+			continue
 		}
 		f(v)
 	}
@@ -432,17 +628,18 @@ func (m *PlayerActivityMgr) getActivity(activityId int64) *Activity {
 // @receiver m
 // @param activityId
 // @return *Activity
+// @return error 活动不存在或不可用
 //
-func (m *PlayerActivityMgr) getStartActivity(activityId int64) *Activity {
+func (m *PlayerActivityMgr) getStartActivity(activityId int64) (*Activity, error) {
 	activity, ok := m.activityMap[activityId]
 	if !ok {
-		return nil
+		return nil, activityNotExist.with("activityId", activityId)
 	}
 	if err := activity.invalid(); err != nil {
 		logWarn("活动不可用", zap.Error(err), zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId))
-		return nil
+		return nil, err
 	}
-	return m.activityMap[activityId]
+	return activity, nil
 }
 
 //
@@ -537,17 +734,24 @@ func (m *PlayerActivityMgr) TriggerCondition(f func(conf *pb.Condition, taskInfo
 // @return error
 //
 func (m *PlayerActivityMgr) Sign(activityId int64, index int) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+	op := &Operation{Type: OpSign, ActivityId: activityId, TplIndex: index}
+	return m.invoke(op, func(*Operation) error {
+		return m.sign(activityId, index)
+	})
+}
+
+func (m *PlayerActivityMgr) sign(activityId int64, index int) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
 	}
 	template := activity.getSignTemplate(index)
 	if template == nil {
-		return templateNotExist
+		return templateNotExist.with("activityId", activityId).with("index", index)
 	}
 	// 登录主动触发
 	if template.isLoginTrigger() {
-		return errors.New("sign trigger error") // 签到触发类型错误
+		return signTriggerError.with("activityId", activityId).with("index", index)
 	}
 	if err := template.sign(m.getPlayer()); err != nil {
 		return err
@@ -561,29 +765,32 @@ func (m *PlayerActivityMgr) Sign(activityId int64, index int) error {
 // @receiver m
 // @param activityId 活动Id
 // @param index 活动模板索引
-// @param day 领取哪天
+// @param day 领取哪天,月历签到为日期(yyyymmdd)
 // @return error
 //
 func (m *PlayerActivityMgr) SignGetReward(activityId int64, index int, day int32) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+	op := &Operation{Type: OpSignGetReward, ActivityId: activityId, TplIndex: index, Args: []interface{}{day}}
+	return m.invoke(op, func(*Operation) error {
+		return m.signGetReward(activityId, index, day)
+	})
+}
+
+func (m *PlayerActivityMgr) signGetReward(activityId int64, index int, day int32) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
 	}
 	template := activity.getSignTemplate(index)
 	if template == nil {
-		return templateNotExist
+		return templateNotExist.with("activityId", activityId).with("index", index)
 	}
-	//// 自动发送签到奖励
-	//if template.isAutoGetReward() {
-	//	return errors.New("sign reward is auto send")
-	//}
 	if err := template.getReward(m.getPlayer(), day); err != nil {
 		logError("领取签到奖励", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId), zap.Int32("day", day), zap.Error(err))
 		return err
 	}
 	// 存档
 	template.saveDB()
-	logError("领取签到奖励", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId), zap.Int32("day", day))
+	logInfo("领取签到奖励", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activityId), zap.Int32("day", day))
 	return nil
 }
 
@@ -593,18 +800,26 @@ func (m *PlayerActivityMgr) SignGetReward(activityId int64, index int, day int32
 // @receiver m
 // @param activityId 活动Id
 // @param index 活动模板索引
+// @param date 月历签到补签日期(yyyymmdd),其他签到模式传0
 // @return error
 //
-func (m *PlayerActivityMgr) SignRepair(activityId int64, index int) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+func (m *PlayerActivityMgr) SignRepair(activityId int64, index int, date int32) error {
+	op := &Operation{Type: OpSignRepair, ActivityId: activityId, TplIndex: index, Args: []interface{}{date}}
+	return m.invoke(op, func(*Operation) error {
+		return m.signRepair(activityId, index, date)
+	})
+}
+
+func (m *PlayerActivityMgr) signRepair(activityId int64, index int, date int32) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
 	}
 	template := activity.getSignTemplate(index)
 	if template == nil {
-		return templateNotExist
+		return templateNotExist.with("activityId", activityId).with("index", index)
 	}
-	if err := template.repair(m.getPlayer()); err != nil {
+	if err := template.repair(m.getPlayer(), date); err != nil {
 		return err
 	}
 	return nil
@@ -620,17 +835,37 @@ func (m *PlayerActivityMgr) SignRepair(activityId int64, index int) error {
 // @return error
 //
 func (m *PlayerActivityMgr) GetTaskReward(activityId int64, index int, taskIndex int32) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+	op := &Operation{Type: OpGetTaskReward, ActivityId: activityId, TplIndex: index, Args: []interface{}{taskIndex}}
+	return m.invoke(op, func(*Operation) error {
+		return m.getTaskReward(activityId, index, taskIndex)
+	})
+}
+
+func (m *PlayerActivityMgr) getTaskReward(activityId int64, index int, taskIndex int32) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
+	}
+	// 公会任务
+	if guild := activity.getGuildTemplate(index); guild != nil {
+		return guild.claim(m.getPlayer(), taskIndex)
+	}
+	// 任务链,taskIndex为当前步骤的分支索引
+	if chain := activity.getChainTemplate(index); chain != nil {
+		if err := chain.claim(m.getPlayer(), taskIndex); err != nil {
+			return err
+		}
+		m.onActivityProgress(activityId)
+		return nil
 	}
 	template := activity.getTaskTemplate(index)
 	if template == nil {
-		return templateNotExist
+		return templateNotExist.with("activityId", activityId).with("index", index)
 	}
 	if err := template.finishTask(m.getPlayer(), taskIndex); err != nil {
 		return err
 	}
+	m.onActivityProgress(activityId)
 	return nil
 }
 
@@ -644,13 +879,20 @@ func (m *PlayerActivityMgr) GetTaskReward(activityId int64, index int, taskIndex
 // @return error
 //
 func (m *PlayerActivityMgr) ShopBuyGoods(activityId int64, index int, goodsIndex int) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+	op := &Operation{Type: OpShopBuyGoods, ActivityId: activityId, TplIndex: index, Args: []interface{}{goodsIndex}}
+	return m.invoke(op, func(*Operation) error {
+		return m.shopBuyGoods(activityId, index, goodsIndex)
+	})
+}
+
+func (m *PlayerActivityMgr) shopBuyGoods(activityId int64, index int, goodsIndex int) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
 	}
 	template := activity.getShopTemplate(index)
 	if template == nil {
-		return templateNotExist
+		return templateNotExist.with("activityId", activityId).with("index", index)
 	}
 	if err := template.buy(m.getPlayer(), goodsIndex); err != nil {
 		return err
@@ -658,25 +900,312 @@ func (m *PlayerActivityMgr) ShopBuyGoods(activityId int64, index int, goodsIndex
 	return nil
 }
 
+//
+// GetGoodsPrice
+// @Description: 获取商品当前价格(阶梯价格和折扣计算后)
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param goodsIndex 商品索引
+// @return *pb.OperateGoodsPrice
+// @return error
+//
+func (m *PlayerActivityMgr) GetGoodsPrice(activityId int64, index int, goodsIndex int) (*pb.OperateGoodsPrice, error) {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return nil, err
+	}
+	template := activity.getShopTemplate(index)
+	if template == nil {
+		return nil, templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	price := template.getGoodsPrice(goodsIndex, nowTimestamp())
+	if price == nil {
+		return nil, goodsNotExist.with("goodsIndex", goodsIndex)
+	}
+	return price, nil
+}
+
 //
 // GetScoreReward
 // @Description: 获取积分奖励
 // @receiver m
 // @param activityId 活动Id
-// @param index 活动模板索引
+// @param index 积分索引
 // @return error
 //
 func (m *PlayerActivityMgr) GetScoreReward(activityId int64, index int) error {
-	activity := m.getStartActivity(activityId)
-	if activity == nil {
-		return activityNotExist
+	op := &Operation{Type: OpGetScoreReward, ActivityId: activityId, TplIndex: -1, Args: []interface{}{index}}
+	return m.invoke(op, func(*Operation) error {
+		return m.getScoreReward(activityId, index)
+	})
+}
+
+func (m *PlayerActivityMgr) getScoreReward(activityId int64, index int) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
 	}
 	if err := activity.getScoreReward(m.getPlayer(), index); err != nil {
 		return err
 	}
+	m.onActivityProgress(activityId)
 	return nil
 }
 
+//
+// Draw
+// @Description: 抽奖
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param lotteryIndex 抽奖索引
+// @return []*pb.ItemData 抽取到的道具
+// @return error
+//
+func (m *PlayerActivityMgr) Draw(activityId int64, index int, lotteryIndex int) ([]*pb.ItemData, error) {
+	var rewards []*pb.ItemData
+	op := &Operation{Type: OpLotteryDraw, ActivityId: activityId, TplIndex: index, Args: []interface{}{lotteryIndex}}
+	err := m.invoke(op, func(*Operation) error {
+		var err error
+		rewards, err = m.draw(activityId, index, lotteryIndex)
+		return err
+	})
+	return rewards, err
+}
+
+func (m *PlayerActivityMgr) draw(activityId int64, index int, lotteryIndex int) ([]*pb.ItemData, error) {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return nil, err
+	}
+	template := activity.getLotteryTemplate(index)
+	if template == nil {
+		return nil, templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.draw(m.getPlayer(), lotteryIndex)
+}
+
+//
+// ClaimMilestone
+// @Description: 领取全服目标档位奖励
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param milestoneIndex 档位索引
+// @return error
+//
+func (m *PlayerActivityMgr) ClaimMilestone(activityId int64, index int, milestoneIndex int32) error {
+	op := &Operation{Type: OpCommunityClaim, ActivityId: activityId, TplIndex: index, Args: []interface{}{milestoneIndex}}
+	return m.invoke(op, func(*Operation) error {
+		return m.claimMilestone(activityId, index, milestoneIndex)
+	})
+}
+
+func (m *PlayerActivityMgr) claimMilestone(activityId int64, index int, milestoneIndex int32) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
+	}
+	template := activity.getCommunityTemplate(index)
+	if template == nil {
+		return templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.claim(m.getPlayer(), milestoneIndex)
+}
+
+//
+// ClaimPassReward
+// @Description: 领取通行证等级奖励
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param level 等级索引,小于0时领取所有可领取奖励
+// @return []*pb.ItemData 领取的奖励
+// @return error
+//
+func (m *PlayerActivityMgr) ClaimPassReward(activityId int64, index int, level int32) ([]*pb.ItemData, error) {
+	var rewards []*pb.ItemData
+	op := &Operation{Type: OpPassClaim, ActivityId: activityId, TplIndex: index, Args: []interface{}{level}}
+	err := m.invoke(op, func(*Operation) error {
+		var err error
+		rewards, err = m.claimPassReward(activityId, index, level)
+		return err
+	})
+	return rewards, err
+}
+
+func (m *PlayerActivityMgr) claimPassReward(activityId int64, index int, level int32) ([]*pb.ItemData, error) {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return nil, err
+	}
+	template := activity.getBattlePassTemplate(index)
+	if template == nil {
+		return nil, templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.claim(m.getPlayer(), level)
+}
+
+//
+// BuyPremiumPass
+// @Description: 购买高级通行证,通过IBattlePassPlayer.OperateBuyPremium完成购买
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @return error
+//
+func (m *PlayerActivityMgr) BuyPremiumPass(activityId int64, index int) error {
+	op := &Operation{Type: OpPassBuyPremium, ActivityId: activityId, TplIndex: index}
+	return m.invoke(op, func(*Operation) error {
+		return m.buyPremiumPass(activityId, index)
+	})
+}
+
+func (m *PlayerActivityMgr) buyPremiumPass(activityId int64, index int) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
+	}
+	template := activity.getBattlePassTemplate(index)
+	if template == nil {
+		return templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.buyPremium(m.getPlayer())
+}
+
+//
+// OnRecharge
+// @Description: 玩家充值,计入所有进行中且货币类型匹配的充值模板,同一订单只计入一次
+// @receiver m
+// @param amount 充值金额
+// @param currency 货币类型
+// @param orderId 订单Id
+// @return error 没有匹配模板时返回模板不匹配错误,订单已被所有匹配模板处理过时返回重复订单错误
+//
+func (m *PlayerActivityMgr) OnRecharge(amount int64, currency string, orderId string) error {
+	op := &Operation{Type: OpRecharge, TplIndex: -1, Args: []interface{}{amount, currency, orderId}}
+	return m.invoke(op, func(*Operation) error {
+		return m.onRecharge(amount, currency, orderId)
+	})
+}
+
+func (m *PlayerActivityMgr) onRecharge(amount int64, currency string, orderId string) error {
+	if amount <= 0 || orderId == "" {
+		return paramError.with("amount", amount).with("orderId", orderId)
+	}
+	matched, recorded := 0, 0
+	m.RangeAllOpen(func(activity *Activity) {
+		activity.rangeTemplates(func(template iTemplate) {
+			recharge, ok := template.(*rechargeTemplate)
+			if !ok || !recharge.isCurrencyMatch(currency) {
+				return
+			}
+			matched++
+			if recharge.recharge(amount, orderId) {
+				recorded++
+			}
+		})
+	})
+	if matched <= 0 {
+		return rechargeNotMatch.with("currency", currency).with("orderId", orderId)
+	}
+	if recorded <= 0 {
+		return rechargeOrderDuplicate.with("orderId", orderId)
+	}
+	return nil
+}
+
+//
+// ClaimRechargeReward
+// @Description: 领取充值档位奖励
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param tierIndex 档位索引
+// @return error
+//
+func (m *PlayerActivityMgr) ClaimRechargeReward(activityId int64, index int, tierIndex int32) error {
+	op := &Operation{Type: OpRechargeClaim, ActivityId: activityId, TplIndex: index, Args: []interface{}{tierIndex}}
+	return m.invoke(op, func(*Operation) error {
+		return m.claimRechargeReward(activityId, index, tierIndex)
+	})
+}
+
+func (m *PlayerActivityMgr) claimRechargeReward(activityId int64, index int, tierIndex int32) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
+	}
+	template := activity.getRechargeTemplate(index)
+	if template == nil {
+		return templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.claim(m.getPlayer(), tierIndex)
+}
+
+//
+// SubmitRankScore
+// @Description: 增加排行活动积分
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param score 增加的积分
+// @return error
+//
+func (m *PlayerActivityMgr) SubmitRankScore(activityId int64, index int, score int64) error {
+	op := &Operation{Type: OpRankSubmit, ActivityId: activityId, TplIndex: index, Args: []interface{}{score}}
+	return m.invoke(op, func(*Operation) error {
+		return m.submitRankScore(activityId, index, score)
+	})
+}
+
+func (m *PlayerActivityMgr) submitRankScore(activityId int64, index int, score int64) error {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return err
+	}
+	template := activity.getRankingTemplate(index)
+	if template == nil {
+		return templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.submitScore(m.getPlayer(), score)
+}
+
+//
+// GetRankList
+// @Description: 分页获取排行榜,活动结束后关闭前依然可以查看
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @param page 页码,从1开始
+// @param pageSize 每页数量
+// @return *pb.OperateRankListS2C
+// @return error
+//
+func (m *PlayerActivityMgr) GetRankList(activityId int64, index int, page int32, pageSize int32) (*pb.OperateRankListS2C, error) {
+	activity := m.getActivity(activityId)
+	if activity == nil {
+		return nil, activityNotExist.with("activityId", activityId)
+	}
+	template := activity.getRankingTemplate(index)
+	if template == nil {
+		return nil, templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.getRankList(m.getPlayer(), page, pageSize)
+}
+
+//
+// settleRanking
+// @Description: 结算所有已结束活动的排名奖励
+// @receiver m
+//
+func (m *PlayerActivityMgr) settleRanking() {
+	m.rangeAll(func(activity *Activity) {
+		activity.settleRanking()
+	})
+}
+
 //
 // PackAllOpenActivity
 // @Description: 打包所有开启活动
@@ -708,6 +1237,20 @@ func (m *PlayerActivityMgr) PackOneActivity(activityId int64) *pb.OperateNewS2C
 	return s2c
 }
 
+//
+// Notifications
+// @Description: 获取所有进行中活动的红点信息
+// @receiver m
+// @return *pb.OperateNotifyS2C
+//
+func (m *PlayerActivityMgr) Notifications() *pb.OperateNotifyS2C {
+	s2c := &pb.OperateNotifyS2C{}
+	m.RangeAllOpen(func(activity *Activity) {
+		s2c.List = append(s2c.List, activity.getNotify(m.getPlayer()))
+	})
+	return s2c
+}
+
 //
 // resetTaskByType
 // @Description: 任务重置检测
@@ -717,27 +1260,62 @@ func (m *PlayerActivityMgr) PackOneActivity(activityId int64) *pb.OperateNewS2C
 func (m *PlayerActivityMgr) resetTaskByType(resetType pb.TaskRefreshType) {
 	// 遍历所有任务
 	m.rangeAll(func(activity *Activity) {
-		var rewardList []*pb.ItemData
-		f := func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
-			if conf.GetRefreshType() != resetType {
-				return false
-			}
-			// 添加未领取奖励
-			if taskInfo.GetTaskState() != pb.OperateTaskState_OTS_Finish {
-				rewardList = append(rewardList, conf.GetRewardList()...)
+		mail := newOperateMail(activity, pb.OperateMailReason_OMR_TASK_RESET)
+		mail.ResetType = resetType
+		resetFun := func(source *pb.OperateMailSource) RangeTaskFunType {
+			return func(conf *pb.Condition, taskInfo *pb.OperateTaskInfo) bool {
+				if conf.GetRefreshType() != resetType {
+					return false
+				}
+				// 添加完成未领取的奖励
+				if taskInfo.GetTaskState() == pb.OperateTaskState_OTS_Finish {
+					source.Items = append(source.Items, conf.GetRewardList()...)
+				}
+
+				// 重置任务状态和进度
+				*taskInfo = pb.OperateTaskInfo{}
+
+				return true
 			}
-
-			// 重置任务状态和进度
-			*taskInfo = pb.OperateTaskInfo{}
-
-			return true
 		}
-		activity.rangeAllCondition(f)
-
-		// 邮件发送未领取奖励
-		if len(rewardList) > 0 {
-			_ = m.getPlayer().OperateSendMail(activity.getId(), rewardList)
+		// 前置任务
+		preSource := &pb.OperateMailSource{Type: pb.OperateMailSourceType_OMST_PRE_TASK}
+		activity.rangeAllPreTask(resetFun(preSource))
+		addMailSource(mail, preSource)
+
+		// 只重置开启的活动
+		if err := activity.invalid(); err == nil {
+			activity.rangeTemplates(func(template iTemplate) {
+				source := newTemplateMailSource(template, pb.OperateMailSourceType_OMST_TEMPLATE)
+				template.rangeTasks(resetFun(source))
+				addMailSource(mail, source)
+			})
 		}
+
+		// 所有未领取奖励合并为一封邮件
+		trace := newLedgerTrace(activity.getId(), LedgerSourceResetMail, int32(resetType))
+		_ = m.operateSendMail(trace, mail)
+	})
+}
+
+//
+// resetShopBuyCountByType
+// @Description: 商品购买次数重置
+// @receiver m
+// @param resetType
+//
+func (m *PlayerActivityMgr) resetShopBuyCountByType(resetType pb.TaskRefreshType) {
+	m.rangeAll(func(act *Activity) {
+		act.rangeTemplates(func(template iTemplate) {
+			if template.getType() != pb.ActivityTemplateType_CONSUMPTION_TYPE {
+				return
+			}
+			shop, ok := template.(*shopTemplate)
+			if !ok {
+				return
+			}
+			shop.resetBuyCount(resetType)
+		})
 	})
 }
 
@@ -769,6 +1347,8 @@ func (m *PlayerActivityMgr) resetEveryDaySignRepairCount() {
 func (m *PlayerActivityMgr) OnNewDay() {
 	// 重置任务状态
 	m.resetTaskByType(pb.TaskRefreshType_TRT_DAY)
+	// 重置商品购买次数
+	m.resetShopBuyCountByType(pb.TaskRefreshType_TRT_DAY)
 	// 重置签到每日补签次数
 	m.resetEveryDaySignRepairCount()
 	// 触发一次自动签到
@@ -783,6 +1363,8 @@ func (m *PlayerActivityMgr) OnNewDay() {
 func (m *PlayerActivityMgr) OnNewWeek() {
 	// 重置任务状态
 	m.resetTaskByType(pb.TaskRefreshType_TRT_WEEK)
+	// 重置商品购买次数
+	m.resetShopBuyCountByType(pb.TaskRefreshType_TRT_WEEK)
 }
 
 //
@@ -793,4 +1375,6 @@ func (m *PlayerActivityMgr) OnNewWeek() {
 func (m *PlayerActivityMgr) OnNewMonth() {
 	// 重置任务状态
 	m.resetTaskByType(pb.TaskRefreshType_TRT_MONTH)
+	// 重置商品购买次数
+	m.resetShopBuyCountByType(pb.TaskRefreshType_TRT_MONTH)
 }
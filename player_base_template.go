@@ -32,6 +32,8 @@ type iTemplate interface {
 	getIndex() int32
 	getType() pb.ActivityTemplateType
 	getCanReceiveReward() []*pb.ItemData
+	getNotify(player IPlayer) *pb.OperateNotifyTemplate
+	getClientData() *pb.OperateTemplateClient
 	rangeTasks(f RangeTaskFunType)
 	getDbData() *pb.ActivityTemplateDB
 	initData()
@@ -91,6 +93,14 @@ func (m *baseTemplate) getCanReceiveReward() []*pb.ItemData {
 	return nil
 }
 
+func (m *baseTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	return nil
+}
+
+func (m *baseTemplate) getClientData() *pb.OperateTemplateClient {
+	return nil
+}
+
 func (m *baseTemplate) getDay() int32 {
 	return m.day
 }
@@ -104,6 +114,21 @@ func (m *baseTemplate) getDbData() *pb.ActivityTemplateDB {
 	return m.dbData
 }
 
+//
+// newLedgerTrace
+// @Description: 生成模板流水追踪信息
+// @receiver m
+// @param source 来源
+// @param index 签到天数/商品索引/任务索引
+// @return *ledgerTrace
+//
+func (m *baseTemplate) newLedgerTrace(source LedgerSource, index int32) *ledgerTrace {
+	trace := newLedgerTrace(m.activity.getId(), source, index)
+	trace.day = m.day
+	trace.tplIndex = m.index
+	return trace
+}
+
 func templateParameterCheck(data *pb.ActivityTemplate, activity *Activity) error {
 	if data == nil {
 		return errors.New("data is nil ")
@@ -0,0 +1,180 @@
+/**
+ * @Author: dingqinghui
+ * @Description:活动依赖
+ * @File:  player_dependency
+ * @Version: 1.0.0
+ * @Date: 2022/8/25 11:08
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+// PlayerRecordFun 玩家活动记录更改回调函数,record为完整记录
+type PlayerRecordFun func(playerId int32, record *pb.OperatePlayerDB)
+
+//
+// WithActivityRecord
+// @Description: 设置玩家活动记录,活动删除后依然保留,用于活动依赖判断
+// @param record 存档中的活动记录,可为nil
+// @param f 记录更改回调
+// @return PlayerOption
+//
+func WithActivityRecord(record *pb.OperatePlayerDB, f PlayerRecordFun) PlayerOption {
+	return func(m *PlayerActivityMgr) {
+		m.record = record
+		m.recordCallback = f
+	}
+}
+
+//
+// isFinalScoreGot
+// @Description: 最后一档积分奖励是否已领取
+// @receiver m
+// @return bool
+//
+func (m *Activity) isFinalScoreGot() bool {
+	count := len(m.getConf().GetScoreSystem())
+	if count <= 0 {
+		return false
+	}
+	return m.isGotScoreReward(count - 1)
+}
+
+//
+// isCompleted
+// @Description: 活动是否完成,任务奖励和积分奖励全部领取且任务链走完,没有任务和积分的活动不会完成
+// @receiver m
+// @return bool
+//
+func (m *Activity) isCompleted() bool {
+	count := 0
+	for i := range m.getConf().GetScoreSystem() {
+		if !m.isGotScoreReward(i) {
+			return false
+		}
+		count++
+	}
+	completed := true
+	m.rangeTemplates(func(template iTemplate) {
+		// 任务链需要走完
+		if chain, ok := template.(*chainTemplate); ok {
+			if !chain.getChainData().GetFinished() {
+				completed = false
+			}
+			count++
+			return
+		}
+		task, ok := template.(*taskTemplate)
+		if !ok {
+			return
+		}
+		for _, taskInfo := range task.getTaskData().GetTaskInfo() {
+			if taskInfo.GetTaskState() != pb.OperateTaskState_OTS_Over {
+				completed = false
+			}
+			count++
+		}
+	})
+	return completed && count > 0
+}
+
+//
+// getActivityRecord
+// @Description: 获取活动记录
+// @receiver m
+// @param activityId
+// @return *pb.OperateActivityRecord 无记录返回nil
+//
+func (m *PlayerActivityMgr) getActivityRecord(activityId int64) *pb.OperateActivityRecord {
+	return m.record.GetRecords()[activityId]
+}
+
+//
+// updateActivityRecord
+// @Description: 根据活动当前状态更新活动记录,记录只增不减
+// @receiver m
+// @param activity
+// @return bool true:记录有更新
+//
+func (m *PlayerActivityMgr) updateActivityRecord(activity *Activity) bool {
+	if activity == nil {
+		return false
+	}
+	completed, finalScoreGot := activity.isCompleted(), activity.isFinalScoreGot()
+	if !completed && !finalScoreGot {
+		return false
+	}
+	record := m.getActivityRecord(activity.getId())
+	if record.GetCompleted() == completed && record.GetFinalScoreGot() == finalScoreGot {
+		return false
+	}
+	if m.record == nil {
+		m.record = &pb.OperatePlayerDB{}
+	}
+	if m.record.Records == nil {
+		m.record.Records = make(map[int64]*pb.OperateActivityRecord)
+	}
+	m.record.Records[activity.getId()] = &pb.OperateActivityRecord{
+		Completed:     completed || record.GetCompleted(),
+		FinalScoreGot: finalScoreGot || record.GetFinalScoreGot(),
+		UpdateTime:    nowTimestamp(),
+	}
+	if m.recordCallback != nil {
+		m.recordCallback(m.getPlayerId(), m.record)
+	}
+	logInfo("更新玩家活动记录", zap.Int32("playerId", m.getPlayerId()), zap.Int64("activityId", activity.getId()),
+		zap.Bool("completed", completed), zap.Bool("finalScoreGot", finalScoreGot))
+	return true
+}
+
+//
+// onActivityProgress
+// @Description: 活动进度变化,记录有更新时检测依赖该活动的活动是否可添加
+// @receiver m
+// @param activityId
+//
+func (m *PlayerActivityMgr) onActivityProgress(activityId int64) {
+	if m.updateActivityRecord(m.getActivity(activityId)) {
+		m.checkAndAddGlobalActivity()
+	}
+}
+
+//
+// isDependencyFinished
+// @Description: 依赖是否满足,优先使用当前活动状态,活动已删除时使用活动记录
+// @receiver m
+// @param dependency
+// @return bool
+//
+func (m *PlayerActivityMgr) isDependencyFinished(dependency *pb.ActivityDependency) bool {
+	record := m.getActivityRecord(dependency.GetActivityId())
+	activity := m.getActivity(dependency.GetActivityId())
+	switch dependency.GetType() {
+	case pb.ActivityDependType_ADT_COMPLETE:
+		return record.GetCompleted() || (activity != nil && activity.isCompleted())
+	case pb.ActivityDependType_ADT_FINAL_SCORE:
+		return record.GetFinalScoreGot() || (activity != nil && activity.isFinalScoreGot())
+	}
+	return false
+}
+
+//
+// checkDependencies
+// @Description: 检测活动依赖是否全部满足
+// @receiver m
+// @param conf
+// @return error
+//
+func (m *PlayerActivityMgr) checkDependencies(conf *pb.OperateActivity) error {
+	for _, dependency := range conf.GetDependencies() {
+		if !m.isDependencyFinished(dependency) {
+			return dependencyNotFinish.with("activityId", conf.GetId()).
+				with("dependActivityId", dependency.GetActivityId()).with("dependType", dependency.GetType())
+		}
+	}
+	return nil
+}
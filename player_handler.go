@@ -0,0 +1,132 @@
+/**
+ * @Author: dingqinghui
+ * @Description:客户端协议分发
+ * @File:  player_handler
+ * @Version: 1.0.0
+ * @Date: 2022/8/10 14:20
+ */
+
+package activity
+
+import (
+	"fmt"
+	"github.com/dingqinghui/activity/pb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"strings"
+)
+
+// protoPackage 协议包名
+const protoPackage = "Game"
+
+//
+// HandleBytes
+// @Description: 根据协议名解析C2S消息并处理
+// @receiver m
+// @param msgName 协议名,如OperateSignC2S或Game.OperateSignC2S
+// @param data 协议数据
+// @return proto.Message 对应的S2C消息
+// @return error
+//
+func (m *PlayerActivityMgr) HandleBytes(msgName string, data []byte) (proto.Message, error) {
+	if !strings.Contains(msgName, ".") {
+		msgName = protoPackage + "." + msgName
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(msgName))
+	if err != nil {
+		return nil, unknownMsg.with("msg", msgName).wrap(err)
+	}
+	msg := proto.MessageV1(mt.New().Interface())
+	if err = proto.Unmarshal(data, msg); err != nil {
+		return nil, paramError.with("msg", msgName).wrap(err)
+	}
+	return m.Handle(msg)
+}
+
+//
+// Handle
+// @Description: 处理客户端C2S消息,返回对应的S2C消息
+// @receiver m
+// @param msg C2S消息
+// @return proto.Message S2C消息
+// @return error
+//
+func (m *PlayerActivityMgr) Handle(msg proto.Message) (proto.Message, error) {
+	switch c2s := msg.(type) {
+	case *pb.OperateGetListC2S:
+		return m.PackAllOpenActivity(), nil
+	case *pb.OperateNotifyC2S:
+		return m.Notifications(), nil
+	case *pb.OperateGetTaskRewardC2S:
+		return m.handleGetTaskReward(c2s)
+	case *pb.OperateSignC2S:
+		err := m.Sign(c2s.GetActivityId(), int(c2s.GetTplIndex()))
+		return &pb.OperateSignS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Code: ErrorCode(err)}, err
+	case *pb.OperateRepairSignC2S:
+		err := m.SignRepair(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetDate())
+		return &pb.OperateRepairSignS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Date: c2s.GetDate(), Code: ErrorCode(err)}, err
+	case *pb.OperateSignGetRewardC2S:
+		err := m.SignGetReward(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetDay())
+		return &pb.OperateSignGetRewardS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Day: c2s.GetDay(), Code: ErrorCode(err)}, err
+	case *pb.OperateShopBuyC2S:
+		err := m.ShopBuyGoods(c2s.GetActivityId(), int(c2s.GetTplIndex()), int(c2s.GetGoodsIndex()))
+		price, _ := m.GetGoodsPrice(c2s.GetActivityId(), int(c2s.GetTplIndex()), int(c2s.GetGoodsIndex()))
+		return &pb.OperateShopBuyS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), GoodsIndex: c2s.GetGoodsIndex(),
+			Code: ErrorCode(err), Price: price}, err
+	case *pb.OperateGetScoreRewardC2S:
+		err := m.GetScoreReward(c2s.GetActivityId(), int(c2s.GetScoreIndex()))
+		return &pb.OperateGetScoreRewardS2C{ActivityId: c2s.GetActivityId(), ScoreIndex: c2s.GetScoreIndex(), Code: ErrorCode(err)}, err
+	case *pb.OperateLotteryDrawC2S:
+		rewards, err := m.Draw(c2s.GetActivityId(), int(c2s.GetTplIndex()), int(c2s.GetLotteryIndex()))
+		return &pb.OperateLotteryDrawS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(),
+			LotteryIndex: c2s.GetLotteryIndex(), Rewards: rewards, Code: ErrorCode(err)}, err
+	case *pb.OperateCommunityClaimC2S:
+		err := m.ClaimMilestone(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetMilestoneIndex())
+		return &pb.OperateCommunityClaimS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(),
+			MilestoneIndex: c2s.GetMilestoneIndex(), Code: ErrorCode(err)}, err
+	case *pb.OperatePassClaimC2S:
+		rewards, err := m.ClaimPassReward(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetLevel())
+		return &pb.OperatePassClaimS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Level: c2s.GetLevel(),
+			Rewards: rewards, Code: ErrorCode(err)}, err
+	case *pb.OperatePassBuyPremiumC2S:
+		err := m.BuyPremiumPass(c2s.GetActivityId(), int(c2s.GetTplIndex()))
+		return &pb.OperatePassBuyPremiumS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Code: ErrorCode(err)}, err
+	case *pb.OperateRechargeClaimC2S:
+		err := m.ClaimRechargeReward(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetTierIndex())
+		return &pb.OperateRechargeClaimS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), TierIndex: c2s.GetTierIndex(), Code: ErrorCode(err)}, err
+	case *pb.OperateRedeemC2S:
+		rewards, err := m.Redeem(c2s.GetRedeemCode())
+		return &pb.OperateRedeemS2C{RedeemCode: c2s.GetRedeemCode(), Rewards: rewards, Code: ErrorCode(err)}, err
+	case *pb.OperateRankListC2S:
+		s2c, err := m.GetRankList(c2s.GetActivityId(), int(c2s.GetTplIndex()), c2s.GetPage(), c2s.GetPageSize())
+		if err != nil {
+			s2c = &pb.OperateRankListS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex(), Page: c2s.GetPage(), Code: ErrorCode(err)}
+		}
+		return s2c, err
+	case nil:
+		return nil, paramError.with("msg", nil)
+	default:
+		return nil, unknownMsg.with("msg", fmt.Sprintf("%T", msg))
+	}
+}
+
+//
+// handleGetTaskReward
+// @Description: 批量领取任务奖励,遇到错误停止,S2C中返回已领取成功的任务
+// @receiver m
+// @param c2s
+// @return proto.Message
+// @return error
+//
+func (m *PlayerActivityMgr) handleGetTaskReward(c2s *pb.OperateGetTaskRewardC2S) (proto.Message, error) {
+	s2c := &pb.OperateGetTaskRewardS2C{ActivityId: c2s.GetActivityId(), TplIndex: c2s.GetTplIndex()}
+	for _, taskIndex := range c2s.GetTaskIndexs() {
+		if err := m.GetTaskReward(c2s.GetActivityId(), int(c2s.GetTplIndex()), taskIndex); err != nil {
+			s2c.Code = ErrorCode(err)
+			return s2c, err
+		}
+		s2c.TaskIndexs = append(s2c.TaskIndexs, taskIndex)
+	}
+	return s2c, nil
+}
@@ -0,0 +1,168 @@
+/**
+ * @Author: dingqinghui
+ * @Description:玩家操作拦截器
+ * @File:  player_interceptor
+ * @Version: 1.0.0
+ * @Date: 2022/8/16 16:25
+ */
+
+package activity
+
+// OperationType 玩家操作类型
+type OperationType int
+
+// 玩家操作类型
+const (
+	// OpSign 签到
+	OpSign OperationType = iota + 1
+	// OpSignGetReward 领取签到奖励
+	OpSignGetReward
+	// OpSignRepair 补签
+	OpSignRepair
+	// OpGetTaskReward 领取任务奖励
+	OpGetTaskReward
+	// OpShopBuyGoods 购买商品
+	OpShopBuyGoods
+	// OpGetScoreReward 领取积分奖励
+	OpGetScoreReward
+	// OpLotteryDraw 抽奖
+	OpLotteryDraw
+	// OpRankSubmit 提交排行积分
+	OpRankSubmit
+	// OpCommunityClaim 领取全服目标奖励
+	OpCommunityClaim
+	// OpPassClaim 领取通行证奖励
+	OpPassClaim
+	// OpPassBuyPremium 购买高级通行证
+	OpPassBuyPremium
+	// OpRecharge 充值
+	OpRecharge
+	// OpRechargeClaim 领取充值奖励
+	OpRechargeClaim
+	// OpRedeem 兑换码兑换
+	OpRedeem
+)
+
+var operationTypeNames = map[OperationType]string{
+	OpSign:           "sign",
+	OpSignGetReward:  "sign_reward",
+	OpSignRepair:     "sign_repair",
+	OpGetTaskReward:  "task_reward",
+	OpShopBuyGoods:   "shop_buy",
+	OpGetScoreReward: "score_reward",
+	OpLotteryDraw:    "lottery_draw",
+	OpRankSubmit:     "rank_submit",
+	OpCommunityClaim: "community_claim",
+	OpPassClaim:      "pass_claim",
+	OpPassBuyPremium: "pass_buy_premium",
+	OpRecharge:       "recharge",
+	OpRechargeClaim:  "recharge_claim",
+	OpRedeem:         "redeem",
+}
+
+func (t OperationType) String() string {
+	if name, ok := operationTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+//
+// Operation
+// @Description: 玩家操作描述
+//
+type Operation struct {
+	//
+	// Type
+	// @Description: 操作类型
+	//
+	Type OperationType
+	//
+	// ActivityId
+	// @Description: 活动Id
+	//
+	ActivityId int64
+	//
+	// TplIndex
+	// @Description: 活动模板索引,积分奖励操作为-1
+	//
+	TplIndex int
+	//
+	// Args
+	// @Description: 操作参数(签到天数/补签日期/任务索引/商品索引/积分索引/抽奖索引)
+	//
+	Args []interface{}
+	//
+	// RequestId
+	// @Description: 请求Id,记录到操作产生的奖励流水,为空时使用SetRequestId设置的Id
+	//
+	RequestId string
+}
+
+// OperationHandler 操作处理函数
+type OperationHandler func(op *Operation) error
+
+// Interceptor 操作拦截器,调用next继续执行后续拦截器和操作,不调用next则直接短路返回
+type Interceptor func(mgr *PlayerActivityMgr, op *Operation, next OperationHandler) error
+
+//
+// WithInterceptors
+// @Description: 设置玩家操作拦截器
+// @param interceptors
+// @return PlayerOption
+//
+func WithInterceptors(interceptors ...Interceptor) PlayerOption {
+	return func(m *PlayerActivityMgr) {
+		m.Use(interceptors...)
+	}
+}
+
+//
+// Use
+// @Description: 追加玩家操作拦截器,按添加顺序由外到内执行
+// @receiver m
+// @param interceptors
+//
+func (m *PlayerActivityMgr) Use(interceptors ...Interceptor) {
+	for _, interceptor := range interceptors {
+		if interceptor == nil {
+			continue
+		}
+		m.interceptors = append(m.interceptors, interceptor)
+	}
+}
+
+//
+// invoke
+// @Description: 经过拦截器链执行操作
+// @receiver m
+// @param op
+// @param handler
+// @return error
+//
+func (m *PlayerActivityMgr) invoke(op *Operation, handler OperationHandler) error {
+	// 请求Id只在本次操作内有效,嵌套操作沿用外层请求Id
+	if op.RequestId == "" {
+		op.RequestId = m.requestId
+	}
+	if op.RequestId == "" && m.operation != nil {
+		op.RequestId = m.operation.RequestId
+	}
+	m.requestId = ""
+	parent := m.operation
+	m.operation = op
+	defer func() {
+		m.operation = parent
+	}()
+
+	h := handler
+	for i := len(m.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := m.interceptors[i], h
+		h = func(op *Operation) error {
+			return interceptor(m, op, next)
+		}
+	}
+	err := h(op)
+	observeOperation(op.Type.String(), op.ActivityId, err)
+	return err
+}
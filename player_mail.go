@@ -0,0 +1,161 @@
+/**
+ * @Author: dingqinghui
+ * @Description:活动邮件
+ * @File:  player_mail
+ * @Version: 1.0.0
+ * @Date: 2022/9/6 11:05
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+	"sort"
+)
+
+//
+// iMailSourceTemplate
+// @Description: 可按任务提供邮件来源的模板,未实现时按整个模板的可领取奖励生成一个来源
+//
+type iMailSourceTemplate interface {
+	getMailSources() []*pb.OperateMailSource
+}
+
+//
+// newOperateMail
+// @Description: 创建活动邮件,标题和正文key从活动配置中按邮件原因获取
+// @param activity
+// @param reason 邮件原因
+// @return *pb.OperateMail
+//
+func newOperateMail(activity *Activity, reason pb.OperateMailReason) *pb.OperateMail {
+	conf := activity.getConf()
+	mail := &pb.OperateMail{
+		ActivityId:   activity.getId(),
+		Reason:       reason,
+		ActivityName: conf.GetActivityName(),
+	}
+	for _, mailConf := range conf.GetMails() {
+		if mailConf.GetReason() == reason {
+			mail.TitleKey = mailConf.GetTitleKey()
+			mail.BodyKey = mailConf.GetBodyKey()
+			break
+		}
+	}
+	return mail
+}
+
+//
+// newTemplateMailSource
+// @Description: 创建模板邮件来源
+// @param template
+// @param sourceType
+// @return *pb.OperateMailSource
+//
+func newTemplateMailSource(template iTemplate, sourceType pb.OperateMailSourceType) *pb.OperateMailSource {
+	return &pb.OperateMailSource{
+		Type:         sourceType,
+		Day:          template.getDay(),
+		TplIndex:     template.getIndex(),
+		TemplateType: template.getType(),
+	}
+}
+
+//
+// addMailSource
+// @Description: 添加邮件来源,奖励按道具Id合并到邮件奖励中,没有奖励的来源忽略
+// @param mail
+// @param source
+//
+func addMailSource(mail *pb.OperateMail, source *pb.OperateMailSource) {
+	if len(source.GetItems()) <= 0 {
+		return
+	}
+	mail.Sources = append(mail.Sources, source)
+	for _, item := range source.GetItems() {
+		merged := false
+		for _, mailItem := range mail.GetItems() {
+			if mailItem.GetId() == item.GetId() {
+				mailItem.Num += item.GetNum()
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			mail.Items = append(mail.Items, &pb.ItemData{Id: item.GetId(), Num: item.GetNum()})
+		}
+	}
+}
+
+//
+// addRewardMailSources
+// @Description: 添加所有开启模板和积分系统中可领取未领取的奖励
+// @receiver m
+// @param mail
+// @param player
+//
+func (m *Activity) addRewardMailSources(mail *pb.OperateMail, player IPlayer) {
+	m.rangeTemplates(func(template iTemplate) {
+		if sourceTemplate, ok := template.(iMailSourceTemplate); ok {
+			for _, source := range sourceTemplate.getMailSources() {
+				addMailSource(mail, source)
+			}
+			return
+		}
+		source := newTemplateMailSource(template, pb.OperateMailSourceType_OMST_TEMPLATE)
+		source.Items = template.getCanReceiveReward()
+		addMailSource(mail, source)
+	})
+	for _, index := range m.getCanReceiveScoreIndexes(player) {
+		addMailSource(mail, &pb.OperateMailSource{
+			Type:  pb.OperateMailSourceType_OMST_SCORE,
+			Index: int32(index),
+			Items: m.getConf().GetScoreSystem()[index].GetReward(),
+		})
+	}
+}
+
+//
+// addRankMailSources
+// @Description: 活动结束后添加所有未结算排行模板的排名奖励,包含时间嵌套活动中未开启的模板
+// @receiver m
+// @param mail
+// @param player
+// @return func() 邮件发送成功后调用,标记排行已结算
+//
+func (m *Activity) addRankMailSources(mail *pb.OperateMail, player IPlayer) func() {
+	var settles []func()
+	if nowTimestamp() >= m.getEndTime() {
+		days := make([]int32, 0, len(m.templates))
+		for day := range m.templates {
+			days = append(days, day)
+		}
+		sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+		for _, day := range days {
+			for _, template := range m.templates[day] {
+				ranking, ok := template.(*rankingTemplate)
+				if !ok || ranking.getRankingData().GetSettled() {
+					continue
+				}
+				rank, err := ranking.getRank(player)
+				if err != nil {
+					logError("排行结算失败,获取名次失败", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.getId()), zap.Error(err))
+					continue
+				}
+				source := newTemplateMailSource(ranking, pb.OperateMailSourceType_OMST_RANK)
+				source.Index = rank
+				source.Items = ranking.getRankReward(rank)
+				addMailSource(mail, source)
+				settles = append(settles, func() {
+					ranking.markSettled(player, rank)
+				})
+			}
+		}
+	}
+	return func() {
+		for _, settle := range settles {
+			settle()
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/**
+ * @Author: dingqinghui
+ * @Description:活动前置条件
+ * @File:  player_precondition
+ * @Version: 1.0.0
+ * @Date: 2022/8/24 10:42
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+)
+
+//
+// getPreCondTree
+// @Description: 获取前置条件表达式树,未配置时根据前置条件组和NeedPreCondAllFinished生成
+// @receiver m
+// @return *pb.PreCondNode 无前置条件返回nil
+//
+func (m *Activity) getPreCondTree() *pb.PreCondNode {
+	conf := m.getConf()
+	if conf.GetPreCondTree() != nil {
+		return conf.GetPreCondTree()
+	}
+	if len(conf.GetPreConditionGroup()) <= 0 {
+		return nil
+	}
+	// 组内任务全部完成,组之间按NeedPreCondAllFinished组合
+	root := &pb.PreCondNode{Type: pb.PreCondNodeType_PCN_OR}
+	if conf.GetNeedPreCondAllFinished() {
+		root.Type = pb.PreCondNodeType_PCN_AND
+	}
+	for i, group := range conf.GetPreConditionGroup() {
+		groupNode := &pb.PreCondNode{Type: pb.PreCondNodeType_PCN_AND}
+		for j := range group.GetPreCondition() {
+			groupNode.Children = append(groupNode.Children, &pb.PreCondNode{
+				Type:  pb.PreCondNodeType_PCN_LEAF,
+				Group: int32(i),
+				Index: int32(j),
+			})
+		}
+		root.Children = append(root.Children, groupNode)
+	}
+	return root
+}
+
+//
+// isPreTaskFinished
+// @Description: 前置任务是否完成,索引越界视为未完成
+// @receiver m
+// @param group 前置条件组索引
+// @param index 前置条件索引
+// @return bool
+//
+func (m *Activity) isPreTaskFinished(group int32, index int32) bool {
+	groups := m.getDbData().GetPreTaskGroup()
+	if group < 0 || int(group) >= len(groups) {
+		return false
+	}
+	tasks := groups[group].GetPreTaskInfos()
+	if index < 0 || int(index) >= len(tasks) {
+		return false
+	}
+	return tasks[index].GetTaskState() != pb.OperateTaskState_OTS_Doing
+}
+
+//
+// evalPreCondNode
+// @Description: 计算前置条件节点进度
+// @receiver m
+// @param node
+// @return *pb.OperatePreCondNode
+//
+func (m *Activity) evalPreCondNode(node *pb.PreCondNode) *pb.OperatePreCondNode {
+	result := &pb.OperatePreCondNode{Type: node.GetType()}
+	if node.GetType() == pb.PreCondNodeType_PCN_LEAF {
+		result.NeedCount = 1
+		if m.isPreTaskFinished(node.GetGroup(), node.GetIndex()) {
+			result.FinishedCount = 1
+			result.Finished = true
+		}
+		return result
+	}
+
+	for _, child := range node.GetChildren() {
+		childResult := m.evalPreCondNode(child)
+		if childResult.GetFinished() {
+			result.FinishedCount++
+		}
+		result.Children = append(result.Children, childResult)
+	}
+
+	children := int32(len(result.GetChildren()))
+	switch node.GetType() {
+	case pb.PreCondNodeType_PCN_AND:
+		result.NeedCount = children
+	case pb.PreCondNodeType_PCN_OR:
+		if children > 0 {
+			result.NeedCount = 1
+		}
+	case pb.PreCondNodeType_PCN_NOT:
+		// 只取第一个子节点,子节点未完成即完成
+		result.NeedCount = 1
+		result.FinishedCount = 0
+		if children <= 0 || !result.GetChildren()[0].GetFinished() {
+			result.FinishedCount = 1
+		}
+		result.Children = result.Children[:minInt32(children, 1)]
+	case pb.PreCondNodeType_PCN_AT_LEAST:
+		if node.GetCount() > 0 {
+			result.NeedCount = minInt32(node.GetCount(), children)
+		}
+	}
+	result.Finished = result.GetFinishedCount() >= result.GetNeedCount()
+	return result
+}
+
+//
+// getPreCondProgress
+// @Description: 获取前置条件进度
+// @receiver m
+// @return *pb.OperatePreCondNode 无前置条件返回nil
+//
+func (m *Activity) getPreCondProgress() *pb.OperatePreCondNode {
+	tree := m.getPreCondTree()
+	if tree == nil {
+		return nil
+	}
+	return m.evalPreCondNode(tree)
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
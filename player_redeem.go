@@ -0,0 +1,100 @@
+/**
+ * @Author: dingqinghui
+ * @Description:玩家兑换码兑换
+ * @File:  player_redeem
+ * @Version: 1.0.0
+ * @Date: 2022/9/5 14:18
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+//
+// Redeem
+// @Description: 兑换码兑换,奖励通过IPlayer.OperateAddReward发放
+// @receiver m
+// @param code 兑换码,不区分大小写
+// @return []*pb.ItemData 获得的奖励
+// @return error
+//
+func (m *PlayerActivityMgr) Redeem(code string) ([]*pb.ItemData, error) {
+	var rewards []*pb.ItemData
+	op := &Operation{Type: OpRedeem, TplIndex: -1, Args: []interface{}{code}}
+	err := m.invoke(op, func(*Operation) error {
+		var err error
+		rewards, err = m.redeem(normalizeRedeemCode(code))
+		return err
+	})
+	return rewards, err
+}
+
+func (m *PlayerActivityMgr) redeem(code string) ([]*pb.ItemData, error) {
+	if code == "" {
+		return nil, paramError.with("code", code)
+	}
+	store := getGlobalOperateActivityMgr().redeemStore
+	batch, err := store.GetBatch(code)
+	if err != nil {
+		return nil, dbError.with("code", code).wrap(err)
+	}
+	if batch == nil {
+		return nil, redeemCodeNotExist.with("code", code)
+	}
+	if err = m.checkRedeemBatch(batch); err != nil {
+		return nil, err
+	}
+
+	result, err := store.Use(code, m.getPlayerId())
+	if err != nil {
+		return nil, dbError.with("code", code).wrap(err)
+	}
+	switch result {
+	case RedeemUseCodeLimit:
+		return nil, redeemCodeUsedUp.with("code", code)
+	case RedeemUsePlayerLimit:
+		return nil, redeemPlayerLimit.with("code", code).with("batchId", batch.BatchId)
+	}
+
+	trace := newLedgerTrace(batch.ActivityId, LedgerSourceRedeem, 0)
+	if err = m.operateAddReward(trace, batch.Reward); err != nil {
+		// 奖励发放失败撤销使用次数
+		if revertErr := store.Revert(code, m.getPlayerId()); revertErr != nil {
+			logError("撤销兑换码使用失败", zap.Int32("playerId", m.getPlayerId()), zap.String("code", code), zap.Error(revertErr))
+		}
+		return nil, addRewardFail.with("code", code).wrap(err)
+	}
+	logInfo("兑换码兑换成功", zap.Int32("playerId", m.getPlayerId()), zap.String("code", code),
+		zap.String("batchId", batch.BatchId), zap.Int64("activityId", batch.ActivityId))
+	return batch.Reward, nil
+}
+
+//
+// checkRedeemBatch
+// @Description: 检查有效期、所属活动和渠道/区服限制
+// @receiver m
+// @param batch
+// @return error
+//
+func (m *PlayerActivityMgr) checkRedeemBatch(batch *RedeemBatch) error {
+	now := nowTimestamp()
+	if (batch.StartTime > 0 && now < batch.StartTime) || (batch.EndTime > 0 && now > batch.EndTime) {
+		return redeemCodeExpired.with("batchId", batch.BatchId).with("startTime", batch.StartTime).with("endTime", batch.EndTime)
+	}
+	// 绑定活动的兑换码只能在活动进行中兑换
+	if batch.ActivityId != 0 {
+		if _, err := m.getStartActivity(batch.ActivityId); err != nil {
+			return redeemCodeExpired.with("batchId", batch.BatchId).with("activityId", batch.ActivityId).wrap(err)
+		}
+	}
+	if len(batch.Channels) > 0 && !containsInt32(batch.Channels, m.getChannel()) {
+		return redeemRestricted.with("batchId", batch.BatchId).with("channel", m.getChannel())
+	}
+	if len(batch.Areas) > 0 && !containsInt32(batch.Areas, m.getArea()) {
+		return redeemRestricted.with("batchId", batch.BatchId).with("areaId", m.getArea())
+	}
+	return nil
+}
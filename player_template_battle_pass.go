@@ -0,0 +1,319 @@
+/**
+ * @Author: dingqinghui
+ * @Description:通行证模板
+ * @File:  player_template_battle_pass
+ * @Version: 1.0.0
+ * @Date: 2022/8/31 10:05
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+//
+// IBattlePassPlayer
+// @Description: 通行证玩家接口,IPlayer实现该接口后才能购买高级通行证
+//
+type IBattlePassPlayer interface {
+	// OperateBuyPremium 购买高级通行证,返回nil表示购买成功
+	OperateBuyPremium(activityId int64, productId int32) error
+}
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_BATTLE_PASS_TYPE, newBattlePassTemplate)
+}
+
+func newBattlePassTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newBattlePassTemplate", zap.Error(err))
+		return nil
+	}
+	result := &battlePassTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有通行证数据时补全
+	if result.getPassData() == nil {
+		result.dbData.BattlePassDB = &pb.BattlePassTemplateDB{}
+	}
+	passData := result.getPassData()
+	if passData.GetFreeClaimed() == nil {
+		passData.FreeClaimed = make(map[int32]bool)
+	}
+	if passData.GetPremiumClaimed() == nil {
+		passData.PremiumClaimed = make(map[int32]bool)
+	}
+	// 配置新增任务时补全任务进度
+	for len(passData.GetTaskInfo()) < len(result.getPassConf().GetTasks()) {
+		passData.TaskInfo = append(passData.TaskInfo, &pb.OperateTaskInfo{})
+	}
+	return result
+}
+
+type battlePassTemplate struct {
+	*baseTemplate
+}
+
+func (m *battlePassTemplate) initData() {
+	taskList := make([]*pb.OperateTaskInfo, 0, len(m.getPassConf().GetTasks()))
+	for range m.getPassConf().GetTasks() {
+		taskList = append(taskList, &pb.OperateTaskInfo{})
+	}
+	m.dbData = &pb.ActivityTemplateDB{
+		BattlePassDB: &pb.BattlePassTemplateDB{
+			FreeClaimed:    make(map[int32]bool),
+			PremiumClaimed: make(map[int32]bool),
+			TaskInfo:       taskList,
+		},
+	}
+}
+
+func (m *battlePassTemplate) getPassConf() *pb.BattlePassTemplate {
+	return m.conf.GetBattlePass()
+}
+
+func (m *battlePassTemplate) getPassData() *pb.BattlePassTemplateDB {
+	return m.dbData.GetBattlePassDB()
+}
+
+//
+// getLevel
+// @Description: 获取当前等级,即已达到的等级数量
+// @receiver m
+// @return int32
+//
+func (m *battlePassTemplate) getLevel() int32 {
+	var level int32
+	xp := m.getPassData().GetXp()
+	for _, levelConf := range m.getPassConf().GetLevels() {
+		if xp < levelConf.GetXp() {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+//
+// rangeTasks
+// @Description: 触发经验任务,任务完成时自动获得经验
+// @receiver m
+// @param f
+//
+func (m *battlePassTemplate) rangeTasks(f RangeTaskFunType) {
+	if f == nil {
+		return
+	}
+	dbData := m.getPassData()
+	needSaveDB := false
+	for index, task := range m.getPassConf().GetTasks() {
+		if index >= len(dbData.GetTaskInfo()) {
+			break
+		}
+		taskInfo := dbData.GetTaskInfo()[index]
+		if taskInfo.GetTaskState() != pb.OperateTaskState_OTS_Doing {
+			continue
+		}
+		if !f(task.GetTask(), taskInfo) {
+			continue
+		}
+		needSaveDB = true
+		if taskInfo.GetTaskState() == pb.OperateTaskState_OTS_Doing {
+			continue
+		}
+		dbData.Xp += task.GetXp()
+		taskInfo.TaskState = pb.OperateTaskState_OTS_Over
+		logInfo("通行证任务完成", zap.Int32("playerId", m.activity.mgr.getPlayerId()), zap.Int64("activityId", m.activity.getId()),
+			zap.Int("taskIndex", index), zap.Int64("xp", dbData.GetXp()))
+	}
+	if needSaveDB {
+		m.saveDB()
+	}
+}
+
+//
+// getLevelReward
+// @Description: 获取等级未领取的奖励,未解锁高级通行证时只包含免费奖励
+// @receiver m
+// @param level 等级索引
+// @return free 免费奖励
+// @return premium 高级奖励
+//
+func (m *battlePassTemplate) getLevelReward(level int32) (free []*pb.ItemData, premium []*pb.ItemData) {
+	dbData := m.getPassData()
+	levelConf := m.getPassConf().GetLevels()[level]
+	if !dbData.GetFreeClaimed()[level] {
+		free = levelConf.GetFreeReward()
+	}
+	if dbData.GetPremium() && !dbData.GetPremiumClaimed()[level] {
+		premium = levelConf.GetPremiumReward()
+	}
+	return free, premium
+}
+
+//
+// claimLevel
+// @Description: 领取等级奖励,免费和高级奖励一起领取
+// @receiver m
+// @param level 等级索引
+// @return []*pb.ItemData
+// @return error
+//
+func (m *battlePassTemplate) claimLevel(level int32) ([]*pb.ItemData, error) {
+	if level < 0 || int(level) >= len(m.getPassConf().GetLevels()) {
+		return nil, passLevelNotExist.with("level", level)
+	}
+	if level >= m.getLevel() {
+		return nil, passLevelNotReached.with("level", level).with("xp", m.getPassData().GetXp())
+	}
+	free, premium := m.getLevelReward(level)
+	if len(free) <= 0 && len(premium) <= 0 {
+		return nil, passRewardClaimed.with("level", level)
+	}
+	rewards := append(append([]*pb.ItemData{}, free...), premium...)
+	trace := m.newLedgerTrace(LedgerSourcePassReward, level)
+	if err := m.activity.mgr.operateAddReward(trace, rewards); err != nil {
+		return nil, addRewardFail.with("level", level).wrap(err)
+	}
+	dbData := m.getPassData()
+	dbData.GetFreeClaimed()[level] = true
+	if dbData.GetPremium() {
+		dbData.GetPremiumClaimed()[level] = true
+	}
+	return rewards, nil
+}
+
+//
+// claim
+// @Description: 领取通行证奖励
+// @receiver m
+// @param player
+// @param level 等级索引,小于0时领取所有已达到等级的未领取奖励(包含解锁高级通行证后补领的高级奖励)
+// @return []*pb.ItemData 领取的奖励
+// @return error
+//
+func (m *battlePassTemplate) claim(player IPlayer, level int32) ([]*pb.ItemData, error) {
+	if level >= 0 {
+		rewards, err := m.claimLevel(level)
+		if err != nil {
+			return nil, err
+		}
+		m.saveDB()
+		logInfo("领取通行证奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()), zap.Int32("level", level))
+		return rewards, nil
+	}
+
+	var rewards []*pb.ItemData
+	for i := int32(0); i < m.getLevel(); i++ {
+		if free, premium := m.getLevelReward(i); len(free) <= 0 && len(premium) <= 0 {
+			continue
+		}
+		items, err := m.claimLevel(i)
+		if err != nil {
+			// 已领取的奖励保存
+			if len(rewards) > 0 {
+				m.saveDB()
+			}
+			return rewards, err
+		}
+		rewards = append(rewards, items...)
+	}
+	if len(rewards) <= 0 {
+		return nil, passRewardClaimed.with("level", level)
+	}
+	m.saveDB()
+	logInfo("一键领取通行证奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()), zap.Any("rewards", rewards))
+	return rewards, nil
+}
+
+//
+// buyPremium
+// @Description: 购买高级通行证,已达到等级的高级奖励可补领
+// @receiver m
+// @param player
+// @return error
+//
+func (m *battlePassTemplate) buyPremium(player IPlayer) error {
+	dbData := m.getPassData()
+	if dbData.GetPremium() {
+		return passPremiumUnlocked.with("activityId", m.activity.getId())
+	}
+	passPlayer, ok := player.(IBattlePassPlayer)
+	if !ok {
+		return passPurchaseFail.with("activityId", m.activity.getId())
+	}
+	if err := passPlayer.OperateBuyPremium(m.activity.getId(), m.getPassConf().GetPremiumProductId()); err != nil {
+		return passPurchaseFail.with("activityId", m.activity.getId()).wrap(err)
+	}
+	dbData.Premium = true
+	m.saveDB()
+	logInfo("购买高级通行证", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()))
+	return nil
+}
+
+//
+// rangeCanReceiveLevel
+// @Description: 遍历所有有未领取奖励的等级
+// @receiver m
+// @param f
+//
+func (m *battlePassTemplate) rangeCanReceiveLevel(f func(free []*pb.ItemData, premium []*pb.ItemData)) {
+	for i := int32(0); i < m.getLevel(); i++ {
+		if free, premium := m.getLevelReward(i); len(free) > 0 || len(premium) > 0 {
+			f(free, premium)
+		}
+	}
+}
+
+func (m *battlePassTemplate) getCanReceiveReward() []*pb.ItemData {
+	var items []*pb.ItemData
+	m.rangeCanReceiveLevel(func(free []*pb.ItemData, premium []*pb.ItemData) {
+		items = append(items, free...)
+		items = append(items, premium...)
+	})
+	return items
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *battlePassTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	m.rangeCanReceiveLevel(func(_ []*pb.ItemData, _ []*pb.ItemData) {
+		notify.PassRewardCount++
+	})
+	return notify
+}
+
+func (m *battlePassTemplate) getClientData() *pb.OperateTemplateClient {
+	return &pb.OperateTemplateClient{
+		Day:       m.getDay(),
+		PassLevel: m.getLevel(),
+	}
+}
+
+func (m *battlePassTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *battlePassTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		BattlePassDB: m.getPassData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
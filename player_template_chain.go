@@ -0,0 +1,250 @@
+/**
+ * @Author: dingqinghui
+ * @Description:任务链模板
+ * @File:  player_template_chain
+ * @Version: 1.0.0
+ * @Date: 2022/9/2 15:30
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_CHAIN_TYPE, newChainTemplate)
+}
+
+func newChainTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newChainTemplate", zap.Error(err))
+		return nil
+	}
+	result := &chainTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有任务链数据时补全
+	if result.getChainData() == nil {
+		result.dbData.ChainDB = &pb.ChainTemplateDB{}
+	}
+	chainData := result.getChainData()
+	// 配置删减步骤后当前步骤不存在时任务链结束
+	if int(chainData.GetStep()) >= len(result.getChainConf().GetSteps()) {
+		chainData.Finished = true
+	}
+	// 配置新增分支时补全任务进度
+	if step := result.getStepConf(); step != nil {
+		for len(chainData.GetTaskInfo()) < len(step.GetBranches()) {
+			chainData.TaskInfo = append(chainData.TaskInfo, &pb.OperateTaskInfo{})
+		}
+	}
+	return result
+}
+
+//
+// chainTemplate
+// @Description: 任务链模板,只有当前步骤的任务会计数,领取当前步骤奖励后才进入下一步骤
+//
+type chainTemplate struct {
+	*baseTemplate
+}
+
+func (m *chainTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		ChainDB: &pb.ChainTemplateDB{
+			Finished: len(m.getChainConf().GetSteps()) <= 0,
+		},
+	}
+	m.resetStepTasks()
+}
+
+func (m *chainTemplate) getChainConf() *pb.ChainTemplate {
+	return m.conf.GetChain()
+}
+
+func (m *chainTemplate) getChainData() *pb.ChainTemplateDB {
+	return m.dbData.GetChainDB()
+}
+
+//
+// getStepConf
+// @Description: 获取当前步骤配置
+// @receiver m
+// @return *pb.ChainStep 任务链结束返回nil
+//
+func (m *chainTemplate) getStepConf() *pb.ChainStep {
+	dbData := m.getChainData()
+	steps := m.getChainConf().GetSteps()
+	if dbData.GetFinished() || dbData.GetStep() < 0 || int(dbData.GetStep()) >= len(steps) {
+		return nil
+	}
+	return steps[dbData.GetStep()]
+}
+
+//
+// resetStepTasks
+// @Description: 重置当前步骤的分支任务进度
+// @receiver m
+//
+func (m *chainTemplate) resetStepTasks() {
+	dbData := m.getChainData()
+	dbData.TaskInfo = nil
+	for range m.getStepConf().GetBranches() {
+		dbData.TaskInfo = append(dbData.TaskInfo, &pb.OperateTaskInfo{})
+	}
+}
+
+//
+// rangeTasks
+// @Description: 只触发当前步骤的分支任务
+// @receiver m
+// @param f
+//
+func (m *chainTemplate) rangeTasks(f RangeTaskFunType) {
+	if f == nil {
+		return
+	}
+	step := m.getStepConf()
+	if step == nil {
+		return
+	}
+	dbData := m.getChainData()
+	needSaveDB := false
+	for index, branch := range step.GetBranches() {
+		if index >= len(dbData.GetTaskInfo()) {
+			break
+		}
+		taskInfo := dbData.GetTaskInfo()[index]
+		if taskInfo.GetTaskState() != pb.OperateTaskState_OTS_Doing {
+			continue
+		}
+		if f(branch.GetTask(), taskInfo) {
+			needSaveDB = true
+		}
+	}
+	if needSaveDB {
+		m.saveDB()
+	}
+}
+
+//
+// getNextStep
+// @Description: 获取分支领奖后进入的步骤
+// @param step 当前步骤索引
+// @param branch
+// @return int32
+//
+func getNextStep(step int32, branch *pb.ChainBranch) int32 {
+	if branch.GetNext() > 0 {
+		return branch.GetNext() - 1
+	}
+	return step + 1
+}
+
+//
+// claim
+// @Description: 领取当前步骤分支奖励并进入下一步骤
+// @receiver m
+// @param player
+// @param branchIndex 分支索引
+// @return error
+//
+func (m *chainTemplate) claim(player IPlayer, branchIndex int32) error {
+	step := m.getStepConf()
+	if step == nil {
+		return taskNotExist.with("taskId", branchIndex).with("finished", true)
+	}
+	branches := step.GetBranches()
+	dbData := m.getChainData()
+	if branchIndex < 0 || int(branchIndex) >= len(branches) || int(branchIndex) >= len(dbData.GetTaskInfo()) {
+		return taskNotExist.with("taskId", branchIndex).with("step", dbData.GetStep())
+	}
+	taskInfo := dbData.GetTaskInfo()[branchIndex]
+	if taskInfo.GetTaskState() != pb.OperateTaskState_OTS_Finish {
+		return taskStateError.with("taskId", branchIndex).with("taskState", taskInfo.GetTaskState())
+	}
+
+	branch := branches[branchIndex]
+	trace := m.newLedgerTrace(LedgerSourceTaskReward, dbData.GetStep())
+	if err := m.activity.mgr.operateAddReward(trace, branch.GetTask().GetRewardList()); err != nil {
+		return addRewardFail.with("taskId", branchIndex).wrap(err)
+	}
+
+	curStep := dbData.GetStep()
+	dbData.History = append(dbData.History, &pb.ChainRecord{Step: curStep, Branch: branchIndex})
+	nextStep := getNextStep(curStep, branch)
+	if nextStep < 0 || int(nextStep) >= len(m.getChainConf().GetSteps()) {
+		dbData.Finished = true
+		dbData.TaskInfo = nil
+	} else {
+		dbData.Step = nextStep
+		m.resetStepTasks()
+	}
+	m.saveDB()
+	logInfo("领取任务链奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("step", curStep), zap.Int32("branch", branchIndex), zap.Int32("nextStep", nextStep), zap.Bool("finished", dbData.GetFinished()))
+	return nil
+}
+
+//
+// getCanReceiveReward
+// @Description: 当前步骤只能领取一个分支,取第一个已完成分支的奖励
+// @receiver m
+// @return []*pb.ItemData
+//
+func (m *chainTemplate) getCanReceiveReward() []*pb.ItemData {
+	step := m.getStepConf()
+	if step == nil {
+		return nil
+	}
+	for index, taskInfo := range m.getChainData().GetTaskInfo() {
+		if index >= len(step.GetBranches()) {
+			break
+		}
+		if taskInfo.GetTaskState() == pb.OperateTaskState_OTS_Finish {
+			return step.GetBranches()[index].GetTask().GetRewardList()
+		}
+	}
+	return nil
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *chainTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	// 多个分支完成时也只能选择一个领取
+	for _, taskInfo := range m.getChainData().GetTaskInfo() {
+		if taskInfo.GetTaskState() == pb.OperateTaskState_OTS_Finish {
+			notify.TaskCount = 1
+			break
+		}
+	}
+	return notify
+}
+
+func (m *chainTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *chainTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		ChainDB: m.getChainData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
@@ -0,0 +1,236 @@
+/**
+ * @Author: dingqinghui
+ * @Description:全服目标模板
+ * @File:  player_template_community
+ * @Version: 1.0.0
+ * @Date: 2022/8/30 14:20
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_COMMUNITY_TYPE, newCommunityTemplate)
+}
+
+func newCommunityTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newCommunityTemplate", zap.Error(err))
+		return nil
+	}
+	result := &communityTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有全服目标数据时补全
+	if result.getCommunityData() == nil {
+		result.dbData.CommunityDB = &pb.CommunityTemplateDB{}
+	}
+	if result.getCommunityData().GetClaimed() == nil {
+		result.getCommunityData().Claimed = make(map[int32]bool)
+	}
+	if result.getCommunityData().GetTaskInfo() == nil {
+		result.getCommunityData().TaskInfo = &pb.OperateTaskInfo{}
+	}
+	return result
+}
+
+//
+// communityTemplate
+// @Description: 全服目标模板,全服进度由全局管理器按活动和区服保存,玩家存档记录个人贡献和领奖状态
+//
+type communityTemplate struct {
+	*baseTemplate
+}
+
+func (m *communityTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		CommunityDB: &pb.CommunityTemplateDB{
+			Claimed:  make(map[int32]bool),
+			TaskInfo: &pb.OperateTaskInfo{},
+		},
+	}
+}
+
+func (m *communityTemplate) getCommunityConf() *pb.CommunityTemplate {
+	return m.conf.GetCommunity()
+}
+
+func (m *communityTemplate) getCommunityData() *pb.CommunityTemplateDB {
+	return m.dbData.GetCommunityDB()
+}
+
+func (m *communityTemplate) getProgressKey() string {
+	return communityCounterKey(m.activity.getId(), m.activity.mgr.getArea(), m.getDay(), m.getIndex())
+}
+
+//
+// getProgress
+// @Description: 获取全服进度
+// @receiver m
+// @return int64
+//
+func (m *communityTemplate) getProgress() int64 {
+	return getGlobalOperateActivityMgr().getCommunityProgress(m.getProgressKey())
+}
+
+//
+// isEligible
+// @Description: 个人贡献是否满足领奖条件
+// @receiver m
+// @return bool
+//
+func (m *communityTemplate) isEligible() bool {
+	return m.getCommunityData().GetContribution() >= m.getCommunityConf().GetMinContribution()
+}
+
+//
+// rangeTasks
+// @Description: 触发任务,个人任务进度与上次上报的差值累加到全服进度和个人贡献
+// @receiver m
+// @param f
+//
+func (m *communityTemplate) rangeTasks(f RangeTaskFunType) {
+	if f == nil || m.getCommunityConf().GetTask() == nil {
+		return
+	}
+	dbData := m.getCommunityData()
+	taskInfo := dbData.GetTaskInfo()
+	reported := taskInfo.GetProgress()
+	if !f(m.getCommunityConf().GetTask(), taskInfo) {
+		return
+	}
+	if delta := int64(taskInfo.GetProgress() - reported); delta > 0 {
+		if _, err := getGlobalOperateActivityMgr().addCommunityProgress(m.getProgressKey(), delta); err != nil {
+			logError("增加全服目标进度失败", zap.Int32("playerId", m.activity.mgr.getPlayerId()), zap.Int64("activityId", m.activity.getId()), zap.Error(err))
+			taskInfo.Progress = reported
+			return
+		}
+		dbData.Contribution += delta
+	}
+	m.saveDB()
+}
+
+//
+// claim
+// @Description: 领取全服目标档位奖励
+// @receiver m
+// @param player
+// @param milestoneIndex
+// @return error
+//
+func (m *communityTemplate) claim(player IPlayer, milestoneIndex int32) error {
+	milestones := m.getCommunityConf().GetMilestones()
+	if milestoneIndex < 0 || int(milestoneIndex) >= len(milestones) {
+		return milestoneNotExist.with("milestoneIndex", milestoneIndex)
+	}
+	dbData := m.getCommunityData()
+	if dbData.GetClaimed()[milestoneIndex] {
+		return milestoneClaimed.with("milestoneIndex", milestoneIndex)
+	}
+	milestone := milestones[milestoneIndex]
+	if progress := m.getProgress(); progress < milestone.GetTarget() {
+		return milestoneNotReached.with("milestoneIndex", milestoneIndex).with("progress", progress)
+	}
+	if !m.isEligible() {
+		return milestoneNotEligible.with("milestoneIndex", milestoneIndex).with("contribution", dbData.GetContribution())
+	}
+
+	trace := m.newLedgerTrace(LedgerSourceCommunityReward, milestoneIndex)
+	if err := m.activity.mgr.operateAddReward(trace, milestone.GetReward()); err != nil {
+		return addRewardFail.with("milestoneIndex", milestoneIndex).wrap(err)
+	}
+	dbData.GetClaimed()[milestoneIndex] = true
+	m.saveDB()
+	logInfo("领取全服目标奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("milestoneIndex", milestoneIndex))
+	return nil
+}
+
+//
+// rangeCanReceiveMilestone
+// @Description: 遍历所有可领取的档位
+// @receiver m
+// @param f
+//
+func (m *communityTemplate) rangeCanReceiveMilestone(f func(milestone *pb.CommunityMilestone)) {
+	if !m.isEligible() {
+		return
+	}
+	progress := m.getProgress()
+	for index, milestone := range m.getCommunityConf().GetMilestones() {
+		if progress < milestone.GetTarget() || m.getCommunityData().GetClaimed()[int32(index)] {
+			continue
+		}
+		f(milestone)
+	}
+}
+
+func (m *communityTemplate) getCanReceiveReward() []*pb.ItemData {
+	var items []*pb.ItemData
+	m.rangeCanReceiveMilestone(func(milestone *pb.CommunityMilestone) {
+		items = append(items, milestone.GetReward()...)
+	})
+	return items
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *communityTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	m.rangeCanReceiveMilestone(func(_ *pb.CommunityMilestone) {
+		notify.MilestoneCount++
+	})
+	return notify
+}
+
+//
+// getGoal
+// @Description: 获取全服目标进度和档位状态
+// @receiver m
+// @return *pb.OperateCommunityGoal
+//
+func (m *communityTemplate) getGoal() *pb.OperateCommunityGoal {
+	progress := m.getProgress()
+	goal := &pb.OperateCommunityGoal{
+		Progress:     progress,
+		Contribution: m.getCommunityData().GetContribution(),
+	}
+	for index, milestone := range m.getCommunityConf().GetMilestones() {
+		goal.Milestones = append(goal.Milestones, &pb.OperateMilestone{
+			Index:   int32(index),
+			Target:  milestone.GetTarget(),
+			Reached: progress >= milestone.GetTarget(),
+			Claimed: m.getCommunityData().GetClaimed()[int32(index)],
+		})
+	}
+	return goal
+}
+
+func (m *communityTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *communityTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		CommunityDB: m.getCommunityData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
@@ -9,7 +9,6 @@
 package activity
 
 import (
-	"errors"
 	"github.com/dingqinghui/activity/pb"
 	"go.uber.org/zap"
 )
@@ -73,32 +72,29 @@ func (m *taskTemplate) getTaskInfo(taskId int32) *pb.OperateTaskInfo {
 func (m *taskTemplate) finishTask(player IPlayer, taskId int32) error {
 	template := m.getTaskConf()
 	if template == nil {
-		return errors.New("template is nil")
+		return confError.with("activityId", m.activity.getId())
 	}
 	taskList := template.GetData()
-	if taskList == nil {
-		return errors.New("taskList is nil")
-	}
-
-	if len(taskList) <= int(taskId) {
-		return errors.New("taskId out range")
+	if taskId < 0 || len(taskList) <= int(taskId) {
+		return taskNotExist.with("taskId", taskId)
 	}
 	condition := taskList[taskId]
 	if condition == nil {
-		return errors.New("task not exist")
+		return taskNotExist.with("taskId", taskId)
 	}
 
 	task := m.getTaskInfo(taskId)
 	if task == nil {
-		return errors.New("task is nil")
+		return dbError.with("taskId", taskId)
 	}
 
 	if task.GetTaskState() != pb.OperateTaskState_OTS_Finish {
-		return errors.New("task status err")
+		return taskStateError.with("taskId", taskId).with("taskState", task.GetTaskState())
 	}
 
-	if err := player.OperateAddReward(m.activity.getId(), condition.GetRewardList()); err != nil {
-		return err
+	trace := m.newLedgerTrace(LedgerSourceTaskReward, taskId)
+	if err := m.activity.mgr.operateAddReward(trace, condition.GetRewardList()); err != nil {
+		return addRewardFail.with("taskId", taskId).wrap(err)
 	}
 
 	task.TaskState = pb.OperateTaskState_OTS_Over
@@ -128,16 +124,20 @@ func (m *taskTemplate) rangeTasks(f RangeTaskFunType) {
 	}
 }
 
-func (m *taskTemplate) getCanReceiveReward() []*pb.ItemData {
-	var items []*pb.ItemData
-
+//
+// rangeCanReceiveTask
+// @Description: 遍历所有完成未领奖的任务
+// @receiver m
+// @param f
+//
+func (m *taskTemplate) rangeCanReceiveTask(f func(index int, taskConf *pb.Condition)) {
 	condition := m.dbData.GetConditionDB()
 	if condition == nil {
-		return nil
+		return
 	}
 	conf := m.getTaskConf()
 	if conf == nil {
-		return nil
+		return
 	}
 
 	for index, task := range condition.GetTaskInfo() {
@@ -146,12 +146,51 @@ func (m *taskTemplate) getCanReceiveReward() []*pb.ItemData {
 		}
 		taskConf := conf.GetData()[index]
 		if task.GetTaskState() == pb.OperateTaskState_OTS_Finish {
-			items = append(items, taskConf.GetRewardList()...)
+			f(index, taskConf)
 		}
 	}
+}
+
+func (m *taskTemplate) getCanReceiveReward() []*pb.ItemData {
+	var items []*pb.ItemData
+	m.rangeCanReceiveTask(func(_ int, taskConf *pb.Condition) {
+		items = append(items, taskConf.GetRewardList()...)
+	})
 	return items
 }
 
+//
+// getMailSources
+// @Description: 按任务获取可领取未领取奖励的邮件来源
+// @receiver m
+// @return []*pb.OperateMailSource
+//
+func (m *taskTemplate) getMailSources() []*pb.OperateMailSource {
+	var sources []*pb.OperateMailSource
+	m.rangeCanReceiveTask(func(index int, taskConf *pb.Condition) {
+		source := newTemplateMailSource(m, pb.OperateMailSourceType_OMST_TASK)
+		source.Index = int32(index)
+		source.Items = taskConf.GetRewardList()
+		sources = append(sources, source)
+	})
+	return sources
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *taskTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	m.rangeCanReceiveTask(func(_ int, _ *pb.Condition) {
+		notify.TaskCount++
+	})
+	return notify
+}
+
 func (m *taskTemplate) saveDB() {
 	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
 }
@@ -0,0 +1,321 @@
+/**
+ * @Author: dingqinghui
+ * @Description:自定义活动模板
+ * @File:  player_template_custom
+ * @Version: 1.0.0
+ * @Date: 2022/8/18 14:36
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+//
+// Template
+// @Description: 自定义活动模板接口,实现时嵌入*BaseTemplate,按需重写方法
+//
+type Template interface {
+	// InitData 首次创建模板时初始化存档数据
+	InitData()
+	// GetCanReceiveReward 获取可领取未领取的奖励,活动删除时邮件补发
+	GetCanReceiveReward() []*pb.ItemData
+	// GetNotify 获取红点信息,无红点返回nil
+	GetNotify(player IPlayer) *pb.OperateNotifyTemplate
+	// RangeTasks 遍历模板任务,任务触发时调用
+	RangeTasks(f RangeTaskFunType)
+}
+
+// NewTemplateFunc 自定义模板构造函数
+type NewTemplateFunc func(base *BaseTemplate) Template
+
+//
+// BaseTemplate
+// @Description: 自定义模板基类,提供配置/存档/活动/玩家访问
+//
+type BaseTemplate struct {
+	*baseTemplate
+}
+
+func (m *BaseTemplate) InitData() {
+}
+
+func (m *BaseTemplate) GetCanReceiveReward() []*pb.ItemData {
+	return nil
+}
+
+func (m *BaseTemplate) GetNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	return nil
+}
+
+func (m *BaseTemplate) RangeTasks(_ RangeTaskFunType) {
+}
+
+//
+// Day
+// @Description: 模板所属天
+// @receiver m
+// @return int32
+//
+func (m *BaseTemplate) Day() int32 {
+	return m.getDay()
+}
+
+//
+// Index
+// @Description: 模板所属索引
+// @receiver m
+// @return int32
+//
+func (m *BaseTemplate) Index() int32 {
+	return m.getIndex()
+}
+
+//
+// Type
+// @Description: 模板类型
+// @receiver m
+// @return pb.ActivityTemplateType
+//
+func (m *BaseTemplate) Type() pb.ActivityTemplateType {
+	return m.getType()
+}
+
+//
+// Conf
+// @Description: 模板Gm配置
+// @receiver m
+// @return *pb.ActivityTemplate
+//
+func (m *BaseTemplate) Conf() *pb.ActivityTemplate {
+	return m.conf
+}
+
+//
+// Data
+// @Description: 模板存档数据
+// @receiver m
+// @return *pb.ActivityTemplateDB
+//
+func (m *BaseTemplate) Data() *pb.ActivityTemplateDB {
+	return m.dbData
+}
+
+//
+// ActivityId
+// @Description: 所属活动Id
+// @receiver m
+// @return int64
+//
+func (m *BaseTemplate) ActivityId() int64 {
+	return m.activity.getId()
+}
+
+//
+// ActivityConf
+// @Description: 所属活动Gm配置
+// @receiver m
+// @return *pb.OperateActivity
+//
+func (m *BaseTemplate) ActivityConf() *pb.OperateActivity {
+	return m.activity.getConf()
+}
+
+//
+// Player
+// @Description: 所属玩家
+// @receiver m
+// @return IPlayer
+//
+func (m *BaseTemplate) Player() IPlayer {
+	return m.activity.mgr.getPlayer()
+}
+
+//
+// UnmarshalConf
+// @Description: 解析模板配置中的自定义配置(ActivityTemplate.Extension)
+// @receiver m
+// @param msg
+// @return error
+//
+func (m *BaseTemplate) UnmarshalConf(msg proto.Message) error {
+	if m.conf.GetExtension() == nil {
+		return confError.with("activityId", m.ActivityId()).with("index", m.getIndex())
+	}
+	return m.conf.GetExtension().UnmarshalTo(proto.MessageV2(msg))
+}
+
+//
+// LoadData
+// @Description: 解析自定义存档(ActivityTemplateDB.Extension),无存档时msg保持不变
+// @receiver m
+// @param msg
+// @return error
+//
+func (m *BaseTemplate) LoadData(msg proto.Message) error {
+	if m.dbData.GetExtension() == nil {
+		return nil
+	}
+	return m.dbData.GetExtension().UnmarshalTo(proto.MessageV2(msg))
+}
+
+//
+// SaveData
+// @Description: 写入自定义存档并保存
+// @receiver m
+// @param msg
+// @return error
+//
+func (m *BaseTemplate) SaveData(msg proto.Message) error {
+	ext, err := anypb.New(proto.MessageV2(msg))
+	if err != nil {
+		return dbError.with("activityId", m.ActivityId()).wrap(err)
+	}
+	m.dbData.Extension = ext
+	m.Save()
+	return nil
+}
+
+//
+// Save
+// @Description: 保存模板存档,通过DataCmdFun回调通知存档更新
+// @receiver m
+//
+func (m *BaseTemplate) Save() {
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): m.dbData},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.ActivityId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	m.activity.callUpdateStatusFun(updateInfo, DataUpdate)
+}
+
+//
+// CheckCost
+// @Description: 检测消耗
+// @receiver m
+// @param items
+// @return error
+//
+func (m *BaseTemplate) CheckCost(items []*pb.ItemData) error {
+	if err := m.Player().OperateCheckCost(m.ActivityId(), items); err != nil {
+		return costNotEnough.with("activityId", m.ActivityId()).wrap(err)
+	}
+	return nil
+}
+
+//
+// SubCost
+// @Description: 扣除消耗并记录流水
+// @receiver m
+// @param source 流水来源
+// @param index 流水索引
+// @param items
+// @return error
+//
+func (m *BaseTemplate) SubCost(source LedgerSource, index int32, items []*pb.ItemData) error {
+	if err := m.activity.mgr.operateSubCost(m.newLedgerTrace(source, index), items); err != nil {
+		return subCostFail.with("activityId", m.ActivityId()).wrap(err)
+	}
+	return nil
+}
+
+//
+// AddReward
+// @Description: 发放奖励并记录流水
+// @receiver m
+// @param source 流水来源
+// @param index 流水索引
+// @param items
+// @return error
+//
+func (m *BaseTemplate) AddReward(source LedgerSource, index int32, items []*pb.ItemData) error {
+	if err := m.activity.mgr.operateAddReward(m.newLedgerTrace(source, index), items); err != nil {
+		return addRewardFail.with("activityId", m.ActivityId()).wrap(err)
+	}
+	return nil
+}
+
+//
+// customTemplate
+// @Description: 自定义模板适配器,将Template适配为内部iTemplate
+//
+type customTemplate struct {
+	*BaseTemplate
+	impl Template
+}
+
+func (m *customTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{}
+	m.impl.InitData()
+}
+
+func (m *customTemplate) getCanReceiveReward() []*pb.ItemData {
+	return m.impl.GetCanReceiveReward()
+}
+
+func (m *customTemplate) getNotify(player IPlayer) *pb.OperateNotifyTemplate {
+	notify := m.impl.GetNotify(player)
+	if notify != nil {
+		notify.TemplateType = m.getType()
+	}
+	return notify
+}
+
+func (m *customTemplate) rangeTasks(f RangeTaskFunType) {
+	if f == nil {
+		return
+	}
+	m.impl.RangeTasks(f)
+}
+
+//
+// newCustomTemplateFunc
+// @Description: 将自定义模板构造函数包装为内部模板构造函数
+// @param f
+// @return newTemplateFunc
+//
+func newCustomTemplateFunc(f NewTemplateFunc) newTemplateFunc {
+	return func(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+		if err := templateParameterCheck(conf, activity); err != nil {
+			logError("newCustomTemplate", zap.Error(err))
+			return nil
+		}
+		base := &BaseTemplate{baseTemplate: newBaseTemplate(day, index, conf, activity, dbData)}
+		impl := f(base)
+		if impl == nil {
+			logError("newCustomTemplate", zap.Int64("activityId", activity.getId()), zap.Any("type", conf.GetTemplateType()))
+			return nil
+		}
+		result := &customTemplate{BaseTemplate: base, impl: impl}
+		result.init(result)
+		return result
+	}
+}
+
+//
+// GetTemplate
+// @Description: 获取进行中活动的自定义模板
+// @receiver m
+// @param activityId 活动Id
+// @param index 活动模板索引
+// @return Template
+// @return error
+//
+func (m *PlayerActivityMgr) GetTemplate(activityId int64, index int) (Template, error) {
+	activity, err := m.getStartActivity(activityId)
+	if err != nil {
+		return nil, err
+	}
+	template, ok := activity.getTemplate(index).(*customTemplate)
+	if !ok {
+		return nil, templateNotExist.with("activityId", activityId).with("index", index)
+	}
+	return template.impl, nil
+}
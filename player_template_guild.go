@@ -0,0 +1,332 @@
+/**
+ * @Author: dingqinghui
+ * @Description:公会模板
+ * @File:  player_template_guild
+ * @Version: 1.0.0
+ * @Date: 2022/8/29 10:36
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+//
+// IGuildPlayer
+// @Description: 公会玩家接口,IPlayer实现该接口后才能参与公会活动
+//
+type IGuildPlayer interface {
+	// GetGuildId 获取所在公会Id,未加入公会返回0
+	GetGuildId() int64
+}
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_GUILD_TYPE, newGuildTemplate)
+}
+
+func newGuildTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newGuildTemplate", zap.Error(err))
+		return nil
+	}
+	result := &guildTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有公会数据时补全
+	if result.getGuildData() == nil {
+		result.dbData.GuildDB = &pb.GuildTemplateDB{}
+	}
+	if result.getGuildData().GetClaimed() == nil {
+		result.getGuildData().Claimed = make(map[int32]bool)
+	}
+	// 配置新增任务时补全任务进度
+	result.fillTaskInfo()
+	return result
+}
+
+//
+// guildTemplate
+// @Description: 公会模板,任务进度和成员贡献保存在全服计数存储中,玩家存档记录领奖状态和已上报的个人任务进度
+//
+type guildTemplate struct {
+	*baseTemplate
+}
+
+func (m *guildTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		GuildDB: &pb.GuildTemplateDB{
+			Claimed: make(map[int32]bool),
+		},
+	}
+	m.fillTaskInfo()
+}
+
+//
+// fillTaskInfo
+// @Description: 按任务配置补全个人任务进度
+// @receiver m
+//
+func (m *guildTemplate) fillTaskInfo() {
+	dbData := m.getGuildData()
+	for len(dbData.GetTaskInfo()) < len(m.getGuildConf().GetTasks()) {
+		dbData.TaskInfo = append(dbData.TaskInfo, &pb.OperateTaskInfo{TaskId: int32(len(dbData.GetTaskInfo()))})
+	}
+}
+
+func (m *guildTemplate) getGuildConf() *pb.GuildTemplate {
+	return m.conf.GetGuild()
+}
+
+func (m *guildTemplate) getGuildData() *pb.GuildTemplateDB {
+	return m.dbData.GetGuildDB()
+}
+
+//
+// getGuildId
+// @Description: 获取玩家所在公会
+// @receiver m
+// @return int64 未实现IGuildPlayer或未加入公会返回0
+//
+func (m *guildTemplate) getGuildId() int64 {
+	player, ok := m.activity.mgr.getPlayer().(IGuildPlayer)
+	if !ok {
+		return 0
+	}
+	return player.GetGuildId()
+}
+
+func (m *guildTemplate) getTaskKey(guildId int64, taskIndex int) string {
+	return guildTaskCounterKey(m.activity.getId(), guildId, m.getDay(), m.getIndex(), taskIndex)
+}
+
+//
+// getTaskProgress
+// @Description: 获取公会任务进度和个人在当前公会的贡献
+// @receiver m
+// @param guildId
+// @param taskIndex
+// @return progress 公会总进度
+// @return contribution 个人贡献
+//
+func (m *guildTemplate) getTaskProgress(guildId int64, taskIndex int) (progress int64, contribution int64) {
+	if guildId <= 0 {
+		return 0, 0
+	}
+	counter := getGlobalOperateActivityMgr().counter
+	key := m.getTaskKey(guildId, taskIndex)
+	var err error
+	if progress, err = counter.Get(key); err != nil {
+		logError("获取公会任务进度失败", zap.String("key", key), zap.Error(err))
+	}
+	if contribution, err = counter.Get(guildMemberCounterKey(key, m.activity.mgr.getPlayerId())); err != nil {
+		logError("获取公会成员贡献失败", zap.String("key", key), zap.Error(err))
+	}
+	return progress, contribution
+}
+
+//
+// getTaskState
+// @Description: 获取任务状态,公会进度达到目标且个人贡献达到要求时可领奖
+// @receiver m
+// @param guildId
+// @param taskIndex
+// @return pb.OperateTaskState
+//
+func (m *guildTemplate) getTaskState(guildId int64, taskIndex int) pb.OperateTaskState {
+	if m.getGuildData().GetClaimed()[int32(taskIndex)] {
+		return pb.OperateTaskState_OTS_Over
+	}
+	if guildId <= 0 {
+		return pb.OperateTaskState_OTS_Doing
+	}
+	task := m.getGuildConf().GetTasks()[taskIndex]
+	progress, contribution := m.getTaskProgress(guildId, taskIndex)
+	if progress < task.GetTarget() || contribution < task.GetMinContribution() {
+		return pb.OperateTaskState_OTS_Doing
+	}
+	return pb.OperateTaskState_OTS_Finish
+}
+
+//
+// rangeTasks
+// @Description: 触发任务,个人任务进度与上次上报的差值作为个人贡献累加到公会总进度,公会进度达到目标后不再累加
+// @receiver m
+// @param f
+//
+func (m *guildTemplate) rangeTasks(f RangeTaskFunType) {
+	if f == nil {
+		return
+	}
+	guildId := m.getGuildId()
+	if guildId <= 0 {
+		return
+	}
+	counter := getGlobalOperateActivityMgr().counter
+	needSaveDB := false
+	for index, task := range m.getGuildConf().GetTasks() {
+		progress, _ := m.getTaskProgress(guildId, index)
+		if progress >= task.GetTarget() || index >= len(m.getGuildData().GetTaskInfo()) {
+			continue
+		}
+		taskInfo := m.getGuildData().GetTaskInfo()[index]
+		reported := taskInfo.GetProgress()
+		if !f(task.GetTask(), taskInfo) {
+			continue
+		}
+		needSaveDB = true
+		delta := int64(taskInfo.GetProgress() - reported)
+		if delta <= 0 {
+			continue
+		}
+		key := m.getTaskKey(guildId, index)
+		if _, err := counter.Add(key, delta); err != nil {
+			logError("增加公会任务进度失败", zap.String("key", key), zap.Error(err))
+			taskInfo.Progress = reported
+			continue
+		}
+		if _, err := counter.Add(guildMemberCounterKey(key, m.activity.mgr.getPlayerId()), delta); err != nil {
+			logError("增加公会成员贡献失败", zap.String("key", key), zap.Error(err))
+		}
+	}
+	if needSaveDB {
+		m.saveDB()
+	}
+}
+
+//
+// claim
+// @Description: 领取公会任务奖励
+// @receiver m
+// @param player
+// @param taskIndex
+// @return error
+//
+func (m *guildTemplate) claim(player IPlayer, taskIndex int32) error {
+	tasks := m.getGuildConf().GetTasks()
+	if taskIndex < 0 || int(taskIndex) >= len(tasks) {
+		return taskNotExist.with("taskId", taskIndex)
+	}
+	guildId := m.getGuildId()
+	if guildId <= 0 {
+		return guildNotJoined.with("activityId", m.activity.getId())
+	}
+	if state := m.getTaskState(guildId, int(taskIndex)); state != pb.OperateTaskState_OTS_Finish {
+		progress, contribution := m.getTaskProgress(guildId, int(taskIndex))
+		if state == pb.OperateTaskState_OTS_Doing && progress >= tasks[taskIndex].GetTarget() {
+			return guildContributionNotEnough.with("taskId", taskIndex).with("contribution", contribution)
+		}
+		return taskStateError.with("taskId", taskIndex).with("taskState", state).with("progress", progress)
+	}
+
+	trace := m.newLedgerTrace(LedgerSourceTaskReward, taskIndex)
+	if err := m.activity.mgr.operateAddReward(trace, tasks[taskIndex].GetTask().GetRewardList()); err != nil {
+		return addRewardFail.with("taskId", taskIndex).wrap(err)
+	}
+	m.getGuildData().GetClaimed()[taskIndex] = true
+	m.saveDB()
+	logInfo("领取公会任务奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int64("guildId", guildId), zap.Int32("taskIndex", taskIndex))
+	return nil
+}
+
+//
+// rangeCanReceiveTask
+// @Description: 遍历所有可领奖的公会任务
+// @receiver m
+// @param f
+//
+func (m *guildTemplate) rangeCanReceiveTask(f func(index int, taskConf *pb.Condition)) {
+	guildId := m.getGuildId()
+	if guildId <= 0 {
+		return
+	}
+	for index, task := range m.getGuildConf().GetTasks() {
+		if m.getTaskState(guildId, index) == pb.OperateTaskState_OTS_Finish {
+			f(index, task.GetTask())
+		}
+	}
+}
+
+func (m *guildTemplate) getCanReceiveReward() []*pb.ItemData {
+	var items []*pb.ItemData
+	m.rangeCanReceiveTask(func(_ int, taskConf *pb.Condition) {
+		items = append(items, taskConf.GetRewardList()...)
+	})
+	return items
+}
+
+//
+// getMailSources
+// @Description: 按公会任务获取可领取未领取奖励的邮件来源
+// @receiver m
+// @return []*pb.OperateMailSource
+//
+func (m *guildTemplate) getMailSources() []*pb.OperateMailSource {
+	var sources []*pb.OperateMailSource
+	m.rangeCanReceiveTask(func(index int, taskConf *pb.Condition) {
+		source := newTemplateMailSource(m, pb.OperateMailSourceType_OMST_TASK)
+		source.Index = int32(index)
+		source.Items = taskConf.GetRewardList()
+		sources = append(sources, source)
+	})
+	return sources
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *guildTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	m.rangeCanReceiveTask(func(_ int, _ *pb.Condition) {
+		notify.TaskCount++
+	})
+	return notify
+}
+
+//
+// getClientData
+// @Description: 获取客户端数据,公会任务进度和个人贡献
+// @receiver m
+// @return *pb.OperateTemplateClient
+//
+func (m *guildTemplate) getClientData() *pb.OperateTemplateClient {
+	guildId := m.getGuildId()
+	client := &pb.OperateTemplateClient{Day: m.getDay()}
+	for index, task := range m.getGuildConf().GetTasks() {
+		progress, contribution := m.getTaskProgress(guildId, index)
+		client.GuildTasks = append(client.GuildTasks, &pb.OperateGuildTask{
+			TaskIndex:    int32(index),
+			Progress:     progress,
+			Target:       task.GetTarget(),
+			Contribution: contribution,
+			TaskState:    m.getTaskState(guildId, index),
+		})
+	}
+	return client
+}
+
+func (m *guildTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *guildTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		GuildDB: m.getGuildData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
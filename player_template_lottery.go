@@ -0,0 +1,246 @@
+/**
+ * @Author: dingqinghui
+ * @Description:抽奖模板
+ * @File:  player_template_lottery
+ * @Version: 1.0.0
+ * @Date: 2022/8/17 10:12
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_LOTTERY_TYPE, newLotteryTemplate)
+}
+
+func newLotteryTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newLotteryTemplate", zap.Error(err))
+		return nil
+	}
+	result := &lotteryTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有抽奖数据时补全
+	if result.getLotteryData() == nil {
+		result.dbData.LotteryDB = &pb.LotteryTemplateDB{}
+	}
+	if result.getLotteryData().GetDrawCounts() == nil {
+		result.getLotteryData().DrawCounts = make(map[int32]int32)
+	}
+	return result
+}
+
+type lotteryTemplate struct {
+	*baseTemplate
+}
+
+func (m *lotteryTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		LotteryDB: &pb.LotteryTemplateDB{
+			DrawCounts: make(map[int32]int32),
+		},
+	}
+}
+
+func (m *lotteryTemplate) getLotteryConf() *pb.LotteryTemplate {
+	return m.conf.GetLottery()
+}
+
+func (m *lotteryTemplate) getLotteryData() *pb.LotteryTemplateDB {
+	return m.dbData.GetLotteryDB()
+}
+
+//
+// getDrawCount
+// @Description: 单次操作抽取次数
+// @receiver m
+// @param lottery
+// @return int32
+//
+func (m *lotteryTemplate) getDrawCount(lottery *pb.Lottery) int32 {
+	if lottery.GetMultiCount() <= 1 {
+		return 1
+	}
+	return lottery.GetMultiCount()
+}
+
+//
+// getCost
+// @Description: 计算抽奖消耗,首次抽取使用首次折扣,多抽使用多抽折扣
+// @receiver m
+// @param lotteryIndex
+// @return []*pb.ItemData
+//
+func (m *lotteryTemplate) getCost(lotteryIndex int) []*pb.ItemData {
+	lottery := m.getLotteryConf().GetLotteryList()[lotteryIndex]
+	count := m.getDrawCount(lottery)
+	isFirst := m.getLotteryData().GetDrawCounts()[int32(lotteryIndex)] <= 0
+
+	var discount int32
+	switch {
+	case count == 1 && isFirst:
+		discount = lottery.GetFirstSingleDiscount()
+	case count > 1 && isFirst && lottery.GetFirstMultiDiscount() > 0:
+		discount = lottery.GetFirstMultiDiscount()
+	case count > 1:
+		discount = lottery.GetMultiDiscount()
+	}
+	num := applyDiscount(lottery.GetSingleCost()*count, discount)
+	return []*pb.ItemData{{Id: m.getLotteryConf().GetCostItemId(), Num: num}}
+}
+
+//
+// draw
+// @Description: 抽奖
+// @receiver m
+// @param player
+// @param lotteryIndex 抽奖索引
+// @return []*pb.ItemData 抽取到的道具
+// @return error
+//
+func (m *lotteryTemplate) draw(player IPlayer, lotteryIndex int) ([]*pb.ItemData, error) {
+	conf := m.getLotteryConf()
+	if conf == nil {
+		return nil, confError.with("activityId", m.activity.getId())
+	}
+	dbData := m.getLotteryData()
+	if dbData == nil {
+		return nil, dbError.with("activityId", m.activity.getId())
+	}
+	if lotteryIndex < 0 || lotteryIndex >= len(conf.GetLotteryList()) {
+		return nil, lotteryNotExist.with("lotteryIndex", lotteryIndex)
+	}
+	pool := getRewardPool(conf.GetRewardPoolId())
+	if len(pool) <= 0 {
+		return nil, lotteryPoolEmpty.with("rewardPoolId", conf.GetRewardPoolId())
+	}
+
+	cost := m.getCost(lotteryIndex)
+	// 检测消耗
+	if err := player.OperateCheckCost(m.activity.getId(), cost); err != nil {
+		return nil, costNotEnough.with("lotteryIndex", lotteryIndex).wrap(err)
+	}
+
+	// 抽取奖励
+	count := m.getDrawCount(conf.GetLotteryList()[lotteryIndex])
+	progress := dbData.GetGuaranteedProgress()
+	rewards := make([]*pb.ItemData, 0, count)
+	for i := int32(0); i < count; i++ {
+		progress++
+		var reward *pb.ItemData
+		if conf.GetGuaranteedCount() > 0 && progress >= conf.GetGuaranteedCount() && conf.GetGuaranteedItem() != nil {
+			reward = conf.GetGuaranteedItem()
+		} else {
+			reward = randomRewardPool(pool)
+		}
+		// 抽到保底道具或包装道具,重置保底进度
+		if reward.GetId() == conf.GetGuaranteedItem().GetId() || reward.GetId() == conf.GetTargetGoods().GetId() {
+			progress = 0
+		}
+		rewards = append(rewards, &pb.ItemData{Id: reward.GetId(), Num: reward.GetNum()})
+	}
+
+	trace := m.newLedgerTrace(LedgerSourceLotteryDraw, int32(lotteryIndex))
+	// 扣除消耗
+	if err := m.activity.mgr.operateSubCost(trace, cost); err != nil {
+		return nil, subCostFail.with("lotteryIndex", lotteryIndex).wrap(err)
+	}
+	// 添加奖励
+	if err := m.activity.mgr.operateAddReward(trace, rewards); err != nil {
+		m.activity.mgr.refundCost(trace, cost)
+		return nil, addRewardFail.with("lotteryIndex", lotteryIndex).wrap(err)
+	}
+
+	dbData.GetDrawCounts()[int32(lotteryIndex)] += 1
+	dbData.GuaranteedProgress = progress
+	dbData.TotalCount += count
+	m.saveDB()
+	logInfo("抽奖成功", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int("lotteryIndex", lotteryIndex), zap.Any("rewards", rewards))
+	return rewards, nil
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param player
+// @return *pb.OperateNotifyTemplate
+//
+func (m *lotteryTemplate) getNotify(player IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	for index := range m.getLotteryConf().GetLotteryList() {
+		if err := player.OperateCheckCost(m.activity.getId(), m.getCost(index)); err != nil {
+			continue
+		}
+		notify.LotteryCount++
+	}
+	return notify
+}
+
+func (m *lotteryTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *lotteryTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		LotteryDB: m.getLotteryData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
+
+//
+// getRewardPool
+// @Description: 根据奖池Id获取奖池
+// @param poolId
+// @return []*pb.RewardPool
+//
+func getRewardPool(poolId int32) []*pb.RewardPool {
+	if rewardPoolCb == nil {
+		return nil
+	}
+	return rewardPoolCb(poolId)
+}
+
+//
+// randomRewardPool
+// @Description: 按权重随机奖池道具
+// @param pool
+// @return *pb.ItemData
+//
+func randomRewardPool(pool []*pb.RewardPool) *pb.ItemData {
+	var total int
+	for _, item := range pool {
+		if item.GetWeightValue() > 0 {
+			total += int(item.GetWeightValue())
+		}
+	}
+	if total <= 0 {
+		return pool[randIntn(len(pool))].GetReward()
+	}
+	r := randIntn(total)
+	for _, item := range pool {
+		if item.GetWeightValue() <= 0 {
+			continue
+		}
+		r -= int(item.GetWeightValue())
+		if r < 0 {
+			return item.GetReward()
+		}
+	}
+	return pool[len(pool)-1].GetReward()
+}
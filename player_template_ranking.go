@@ -0,0 +1,224 @@
+/**
+ * @Author: dingqinghui
+ * @Description:排行模板
+ * @File:  player_template_ranking
+ * @Version: 1.0.0
+ * @Date: 2022/8/26 11:02
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+	"math"
+)
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_RANKING_TYPE, newRankingTemplate)
+}
+
+func newRankingTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newRankingTemplate", zap.Error(err))
+		return nil
+	}
+	result := &rankingTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+	}
+	result.init(result)
+
+	// 旧数据中没有排行数据时补全
+	if result.getRankingData() == nil {
+		result.dbData.RankingDB = &pb.RankingTemplateDB{}
+	}
+	return result
+}
+
+type rankingTemplate struct {
+	*baseTemplate
+}
+
+func (m *rankingTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		RankingDB: &pb.RankingTemplateDB{},
+	}
+}
+
+func (m *rankingTemplate) getRankingConf() *pb.RankingTemplate {
+	return m.conf.GetRanking()
+}
+
+func (m *rankingTemplate) getRankingData() *pb.RankingTemplateDB {
+	return m.dbData.GetRankingDB()
+}
+
+//
+// getRankingKey
+// @Description: 排行榜key
+// @receiver m
+// @return string
+//
+func (m *rankingTemplate) getRankingKey() string {
+	return rankingKey(m.activity.getId(), m.activity.mgr.getArea(), m.getDay(), m.getIndex())
+}
+
+//
+// submitScore
+// @Description: 增加活动积分并更新排行榜
+// @receiver m
+// @param player
+// @param score 增加的积分
+// @return error
+//
+func (m *rankingTemplate) submitScore(player IPlayer, score int64) error {
+	if score <= 0 {
+		return paramError.with("score", score)
+	}
+	dbData := m.getRankingData()
+	dbData.Score += score
+	m.saveDB()
+
+	if dbData.GetScore() < m.getRankingConf().GetMinScore() {
+		return nil
+	}
+	leaderboard := getGlobalOperateActivityMgr().leaderboard
+	if err := leaderboard.Update(m.getRankingKey(), player.GetId(), dbData.GetScore()); err != nil {
+		return dbError.with("key", m.getRankingKey()).wrap(err)
+	}
+	logInfo("提交排行积分", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int64("add", score), zap.Int64("score", dbData.GetScore()))
+	return nil
+}
+
+//
+// getRank
+// @Description: 获取当前名次
+// @receiver m
+// @param player
+// @return int32 0:未上榜
+// @return error
+//
+func (m *rankingTemplate) getRank(player IPlayer) (int32, error) {
+	rank, _, err := getGlobalOperateActivityMgr().leaderboard.Rank(m.getRankingKey(), player.GetId())
+	if err != nil {
+		return 0, dbError.with("key", m.getRankingKey()).wrap(err)
+	}
+	return rank, nil
+}
+
+//
+// getRankList
+// @Description: 分页获取排行榜
+// @receiver m
+// @param player
+// @param page 页码,从1开始
+// @param pageSize 每页数量,超过maxRankPageSize时按maxRankPageSize
+// @return *pb.OperateRankListS2C
+// @return error
+//
+func (m *rankingTemplate) getRankList(player IPlayer, page int32, pageSize int32) (*pb.OperateRankListS2C, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, paramError.with("page", page).with("pageSize", pageSize)
+	}
+	if pageSize > maxRankPageSize {
+		pageSize = maxRankPageSize
+	}
+	if int64(page-1)*int64(pageSize) > math.MaxInt32 {
+		return nil, paramError.with("page", page).with("pageSize", pageSize)
+	}
+	key := m.getRankingKey()
+	leaderboard := getGlobalOperateActivityMgr().leaderboard
+	items, err := leaderboard.Range(key, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, dbError.with("key", key).wrap(err)
+	}
+	total, err := leaderboard.Count(key)
+	if err != nil {
+		return nil, dbError.with("key", key).wrap(err)
+	}
+	rank, err := m.getRank(player)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OperateRankListS2C{
+		ActivityId: m.activity.getId(),
+		TplIndex:   m.getIndex(),
+		Page:       page,
+		Items:      items,
+		Total:      total,
+		SelfRank:   rank,
+		SelfScore:  m.getRankingData().GetScore(),
+	}, nil
+}
+
+//
+// getRankReward
+// @Description: 根据名次获取排名奖励
+// @receiver m
+// @param rank
+// @return []*pb.ItemData
+//
+func (m *rankingTemplate) getRankReward(rank int32) []*pb.ItemData {
+	if rank <= 0 {
+		return nil
+	}
+	for _, reward := range m.getRankingConf().GetRewardList() {
+		if rank >= reward.GetMinRank() && rank <= reward.GetMaxRank() {
+			return reward.GetReward()
+		}
+	}
+	return nil
+}
+
+//
+// markSettled
+// @Description: 标记已结算,排名奖励由活动合并到邮件中发放,邮件发送成功后调用
+// @receiver m
+// @param player
+// @param rank 结算名次
+//
+func (m *rankingTemplate) markSettled(player IPlayer, rank int32) {
+	dbData := m.getRankingData()
+	dbData.Settled = true
+	dbData.SettleRank = rank
+	m.saveDB()
+	logInfo("排行结算成功", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("rank", rank), zap.Int64("score", dbData.GetScore()))
+}
+
+//
+// getClientData
+// @Description: 获取客户端数据,当前名次和积分
+// @receiver m
+// @return *pb.OperateTemplateClient
+//
+func (m *rankingTemplate) getClientData() *pb.OperateTemplateClient {
+	rank, _ := m.getRank(m.activity.mgr.getPlayer())
+	if m.getRankingData().GetSettled() {
+		rank = m.getRankingData().GetSettleRank()
+	}
+	return &pb.OperateTemplateClient{
+		Day:       m.getDay(),
+		Rank:      rank,
+		RankScore: m.getRankingData().GetScore(),
+	}
+}
+
+func (m *rankingTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *rankingTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		RankingDB: m.getRankingData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
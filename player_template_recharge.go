@@ -0,0 +1,258 @@
+/**
+ * @Author: dingqinghui
+ * @Description:充值模板
+ * @File:  player_template_recharge
+ * @Version: 1.0.0
+ * @Date: 2022/9/1 10:12
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+)
+
+func init() {
+	registerTemplate(pb.ActivityTemplateType_RECHARGE_TYPE, newRechargeTemplate)
+}
+
+func newRechargeTemplate(day int32, index int32, conf *pb.ActivityTemplate, activity *Activity, dbData *pb.ActivityTemplateDB) iTemplate {
+	if err := templateParameterCheck(conf, activity); err != nil {
+		logError("newRechargeTemplate", zap.Error(err))
+		return nil
+	}
+	result := &rechargeTemplate{
+		baseTemplate: newBaseTemplate(day, index, conf, activity, dbData),
+		orders:       make(map[string]struct{}),
+	}
+	result.init(result)
+
+	// 旧数据中没有充值数据时补全
+	if result.getRechargeData() == nil {
+		result.dbData.RechargeDB = &pb.RechargeTemplateDB{}
+	}
+	rechargeData := result.getRechargeData()
+	if rechargeData.GetReachCounts() == nil {
+		rechargeData.ReachCounts = make(map[int32]int32)
+	}
+	if rechargeData.GetClaimCounts() == nil {
+		rechargeData.ClaimCounts = make(map[int32]int32)
+	}
+	for _, orderId := range rechargeData.GetOrderIds() {
+		result.orders[orderId] = struct{}{}
+	}
+	return result
+}
+
+//
+// rechargeTemplate
+// @Description: 充值模板,累计档位按累计金额达成,单笔档位按单笔订单金额达成且可配置领取次数
+//
+type rechargeTemplate struct {
+	*baseTemplate
+	orders map[string]struct{} // 已处理订单Id索引,由存档OrderIds构建
+}
+
+func (m *rechargeTemplate) initData() {
+	m.dbData = &pb.ActivityTemplateDB{
+		RechargeDB: &pb.RechargeTemplateDB{
+			ReachCounts: make(map[int32]int32),
+			ClaimCounts: make(map[int32]int32),
+		},
+	}
+}
+
+func (m *rechargeTemplate) getRechargeConf() *pb.RechargeTemplate {
+	return m.conf.GetRecharge()
+}
+
+func (m *rechargeTemplate) getRechargeData() *pb.RechargeTemplateDB {
+	return m.dbData.GetRechargeDB()
+}
+
+//
+// isCurrencyMatch
+// @Description: 货币类型是否计入该模板
+// @receiver m
+// @param currency
+// @return bool
+//
+func (m *rechargeTemplate) isCurrencyMatch(currency string) bool {
+	confCurrency := m.getRechargeConf().GetCurrency()
+	return confCurrency == "" || confCurrency == currency
+}
+
+//
+// hasOrder
+// @Description: 订单是否已处理
+// @receiver m
+// @param orderId
+// @return bool
+//
+func (m *rechargeTemplate) hasOrder(orderId string) bool {
+	_, ok := m.orders[orderId]
+	return ok
+}
+
+//
+// getTierTimes
+// @Description: 档位最多可领取次数
+// @param tier
+// @return int32
+//
+func getTierTimes(tier *pb.RechargeTier) int32 {
+	if tier.GetType() != pb.RechargeTierType_RTT_SINGLE || tier.GetTimes() <= 1 {
+		return 1
+	}
+	return tier.GetTimes()
+}
+
+//
+// getReachCount
+// @Description: 档位达成次数
+// @receiver m
+// @param tierIndex
+// @return int32
+//
+func (m *rechargeTemplate) getReachCount(tierIndex int32) int32 {
+	tier := m.getRechargeConf().GetTiers()[tierIndex]
+	if tier.GetType() == pb.RechargeTierType_RTT_SINGLE {
+		return m.getRechargeData().GetReachCounts()[tierIndex]
+	}
+	if m.getRechargeData().GetTotal() >= tier.GetAmount() {
+		return 1
+	}
+	return 0
+}
+
+//
+// getCanClaimCount
+// @Description: 档位可领取次数
+// @receiver m
+// @param tierIndex
+// @return int32
+//
+func (m *rechargeTemplate) getCanClaimCount(tierIndex int32) int32 {
+	return m.getReachCount(tierIndex) - m.getRechargeData().GetClaimCounts()[tierIndex]
+}
+
+//
+// recharge
+// @Description: 记录充值,同一订单只记录一次
+// @receiver m
+// @param amount 充值金额
+// @param orderId 订单Id
+// @return bool false:订单已处理
+//
+func (m *rechargeTemplate) recharge(amount int64, orderId string) bool {
+	if m.hasOrder(orderId) {
+		return false
+	}
+	dbData := m.getRechargeData()
+	dbData.OrderIds = append(dbData.OrderIds, orderId)
+	m.orders[orderId] = struct{}{}
+	dbData.Total += amount
+	for index, tier := range m.getRechargeConf().GetTiers() {
+		tierIndex := int32(index)
+		if tier.GetType() != pb.RechargeTierType_RTT_SINGLE || amount < tier.GetAmount() {
+			continue
+		}
+		if dbData.GetReachCounts()[tierIndex] < getTierTimes(tier) {
+			dbData.GetReachCounts()[tierIndex]++
+		}
+	}
+	m.saveDB()
+	logInfo("充值活动记录充值", zap.Int32("playerId", m.activity.mgr.getPlayerId()), zap.Int64("activityId", m.activity.getId()),
+		zap.String("orderId", orderId), zap.Int64("amount", amount), zap.Int64("total", dbData.GetTotal()))
+	return true
+}
+
+//
+// claim
+// @Description: 领取充值档位奖励
+// @receiver m
+// @param player
+// @param tierIndex 档位索引
+// @return error
+//
+func (m *rechargeTemplate) claim(player IPlayer, tierIndex int32) error {
+	tiers := m.getRechargeConf().GetTiers()
+	if tierIndex < 0 || int(tierIndex) >= len(tiers) {
+		return rechargeTierNotExist.with("tierIndex", tierIndex)
+	}
+	dbData := m.getRechargeData()
+	if dbData.GetClaimCounts()[tierIndex] >= getTierTimes(tiers[tierIndex]) {
+		return rechargeTierClaimed.with("tierIndex", tierIndex)
+	}
+	if m.getCanClaimCount(tierIndex) <= 0 {
+		return rechargeTierNotReached.with("tierIndex", tierIndex).with("total", dbData.GetTotal())
+	}
+
+	trace := m.newLedgerTrace(LedgerSourceRechargeReward, tierIndex)
+	if err := m.activity.mgr.operateAddReward(trace, tiers[tierIndex].GetReward()); err != nil {
+		return addRewardFail.with("tierIndex", tierIndex).wrap(err)
+	}
+	dbData.GetClaimCounts()[tierIndex]++
+	m.saveDB()
+	logInfo("领取充值奖励", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("tierIndex", tierIndex), zap.Int32("claimCount", dbData.GetClaimCounts()[tierIndex]))
+	return nil
+}
+
+//
+// rangeCanReceiveTier
+// @Description: 遍历所有可领取的档位
+// @receiver m
+// @param f count:可领取次数
+//
+func (m *rechargeTemplate) rangeCanReceiveTier(f func(tier *pb.RechargeTier, count int32)) {
+	for index, tier := range m.getRechargeConf().GetTiers() {
+		if count := m.getCanClaimCount(int32(index)); count > 0 {
+			f(tier, count)
+		}
+	}
+}
+
+func (m *rechargeTemplate) getCanReceiveReward() []*pb.ItemData {
+	var items []*pb.ItemData
+	m.rangeCanReceiveTier(func(tier *pb.RechargeTier, count int32) {
+		for i := int32(0); i < count; i++ {
+			items = append(items, tier.GetReward()...)
+		}
+	})
+	return items
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param _
+// @return *pb.OperateNotifyTemplate
+//
+func (m *rechargeTemplate) getNotify(_ IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	m.rangeCanReceiveTier(func(_ *pb.RechargeTier, count int32) {
+		notify.RechargeRewardCount += count
+	})
+	return notify
+}
+
+func (m *rechargeTemplate) saveDB() {
+	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
+}
+
+func (m *rechargeTemplate) generateUpdateData() *pb.OperateActivityDB {
+	templateDB := &pb.ActivityTemplateDB{
+		RechargeDB: m.getRechargeData(),
+	}
+	list := &pb.ActivityDBList{
+		List: map[int32]*pb.ActivityTemplateDB{m.getIndex(): templateDB},
+	}
+	updateInfo := &pb.OperateActivityDB{
+		ActivityId:   m.activity.getId(),
+		ActivityList: map[int32]*pb.ActivityDBList{m.getDay(): list},
+	}
+	return updateInfo
+}
@@ -9,7 +9,6 @@
 package activity
 
 import (
-	"errors"
 	"github.com/dingqinghui/activity/pb"
 	"go.uber.org/zap"
 )
@@ -54,16 +53,16 @@ func (m *shopTemplate) getShopData() *pb.ConsumptionTemplateDB {
 func (m *shopTemplate) buy(player IPlayer, goodsIndex int) error {
 	conf := m.getShopConf()
 	if conf == nil {
-		return errors.New("shop conf not exist")
+		return confError.with("activityId", m.activity.getId())
 	}
 
 	dbData := m.getShopData()
 	if dbData == nil {
-		return errors.New("shop db data is nil")
+		return dbError.with("activityId", m.activity.getId())
 	}
 
-	if goodsIndex >= len(conf.GetSellGoods()) {
-		return errors.New("shop conf goods not exist")
+	if goodsIndex < 0 || goodsIndex >= len(conf.GetSellGoods()) {
+		return goodsNotExist.with("goodsIndex", goodsIndex)
 	}
 
 	goodsConf := conf.GetSellGoods()[goodsIndex]
@@ -71,38 +70,235 @@ func (m *shopTemplate) buy(player IPlayer, goodsIndex int) error {
 	buyCount := dbData.GetBuyCounts()[int32(goodsIndex)]
 
 	// 限购
-	if goodsConf.GetIsLimit() && buyCount >= goodsConf.GetLimitCount() {
-		return errors.New("goods limit")
+	if m.isLimit(goodsConf, buyCount) {
+		return goodsLimit.with("goodsIndex", goodsIndex).with("buyCount", buyCount)
 	}
 
-	//var costs []*pb.ItemData
-	//for _, item := range goodsConf.GetExpend() {
-	//	costs = append(costs, &pb.ItemData{
-	//		Id:  item.GetId(),
-	//		Num: item.Num * goodsConf.GetDiscount() / 100,
-	//	})
-	//}
+	costs := m.getPrice(goodsConf, buyCount, nowTimestamp())
 
 	// 检测消耗
-	if err := player.OperateCheckCost(m.activity.getId(), goodsConf.GetExpend()); err != nil {
-		return err
+	if err := player.OperateCheckCost(m.activity.getId(), costs); err != nil {
+		return costNotEnough.with("goodsIndex", goodsIndex).wrap(err)
 	}
+	// 扣除全服库存
+	stockKey := m.getStockKey(goodsIndex)
+	if goodsConf.GetStock() > 0 {
+		if err := getGlobalOperateActivityMgr().takeStock(stockKey, goodsConf.GetStock()); err != nil {
+			return err
+		}
+	}
+	trace := m.newLedgerTrace(LedgerSourceShopBuy, int32(goodsIndex))
 	// 扣除消耗
-	if err := player.OperateSubCost(m.activity.getId(), goodsConf.GetExpend()); err != nil {
-		return err
+	if err := m.activity.mgr.operateSubCost(trace, costs); err != nil {
+		m.returnStock(goodsConf, stockKey)
+		return subCostFail.with("goodsIndex", goodsIndex).wrap(err)
 	}
 	// 添加奖励
-	if err := player.OperateAddReward(m.activity.getId(), goodsConf.GetGoods()); err != nil {
-		return err
+	if err := m.activity.mgr.operateAddReward(trace, goodsConf.GetGoods()); err != nil {
+		m.activity.mgr.refundCost(trace, costs)
+		m.returnStock(goodsConf, stockKey)
+		return addRewardFail.with("goodsIndex", goodsIndex).wrap(err)
 	}
 
-	if goodsConf.GetIsLimit() {
-		dbData.GetBuyCounts()[int32(goodsIndex)] = buyCount + 1
-	}
+	// 阶梯价格需要记录所有商品购买次数
+	dbData.GetBuyCounts()[int32(goodsIndex)] = buyCount + 1
 	m.saveDB()
 	return nil
 }
 
+//
+// isLimit
+// @Description: 商品是否达到限购次数
+// @receiver m
+// @param goodsConf
+// @param buyCount
+// @return bool true:已达上限
+//
+func (m *shopTemplate) isLimit(goodsConf *pb.ExchangeGoods, buyCount int32) bool {
+	return goodsConf.GetIsLimit() && buyCount >= goodsConf.GetLimitCount()
+}
+
+//
+// getStockKey
+// @Description: 商品全服库存key
+// @receiver m
+// @param goodsIndex
+// @return string
+//
+func (m *shopTemplate) getStockKey(goodsIndex int) string {
+	return stockCounterKey(m.activity.getId(), m.activity.mgr.getArea(), m.getDay(), m.getIndex(), goodsIndex)
+}
+
+//
+// returnStock
+// @Description: 购买失败归还全服库存
+// @receiver m
+// @param goodsConf
+// @param stockKey
+//
+func (m *shopTemplate) returnStock(goodsConf *pb.ExchangeGoods, stockKey string) {
+	if goodsConf.GetStock() <= 0 {
+		return
+	}
+	getGlobalOperateActivityMgr().returnStock(stockKey)
+}
+
+//
+// getDiscount
+// @Description: 获取商品当前折扣,限时折扣生效期间替代基础折扣
+// @receiver m
+// @param goodsConf
+// @param now
+// @return int32 折扣(百分比),0表示不打折
+// @return int64 限时折扣结束时间,0表示不在限时折扣中
+//
+func (m *shopTemplate) getDiscount(goodsConf *pb.ExchangeGoods, now int64) (int32, int64) {
+	for _, window := range goodsConf.GetSaleWindows() {
+		if now >= window.GetStartTime() && now < window.GetEndTime() {
+			return normalizeDiscount(window.GetDiscount()), window.GetEndTime()
+		}
+	}
+	return normalizeDiscount(goodsConf.GetDiscount()), 0
+}
+
+//
+// getPriceStep
+// @Description: 获取第buyCount+1次购买的阶梯价格
+// @receiver m
+// @param goodsConf
+// @param buyCount 已购买次数
+// @return int32 百分比
+//
+func (m *shopTemplate) getPriceStep(goodsConf *pb.ExchangeGoods, buyCount int32) int32 {
+	steps := goodsConf.GetPriceSteps()
+	if len(steps) <= 0 {
+		return 100
+	}
+	if int(buyCount) >= len(steps) {
+		return steps[len(steps)-1]
+	}
+	return steps[buyCount]
+}
+
+//
+// getPrice
+// @Description: 计算商品当前消耗,阶梯价格和折扣合并计算后向上取整
+// @receiver m
+// @param goodsConf
+// @param buyCount 已购买次数
+// @param now
+// @return []*pb.ItemData
+//
+func (m *shopTemplate) getPrice(goodsConf *pb.ExchangeGoods, buyCount int32, now int64) []*pb.ItemData {
+	percents := []int32{m.getPriceStep(goodsConf, buyCount)}
+	if discount, _ := m.getDiscount(goodsConf, now); discount > 0 {
+		percents = append(percents, discount)
+	}
+	costs := make([]*pb.ItemData, 0, len(goodsConf.GetExpend()))
+	for _, item := range goodsConf.GetExpend() {
+		costs = append(costs, &pb.ItemData{
+			Id:  item.GetId(),
+			Num: applyPercent(item.GetNum(), percents...),
+		})
+	}
+	return costs
+}
+
+//
+// getClientData
+// @Description: 获取商品当前价格
+// @receiver m
+// @return *pb.OperateTemplateClient
+//
+func (m *shopTemplate) getClientData() *pb.OperateTemplateClient {
+	now := nowTimestamp()
+	client := &pb.OperateTemplateClient{Day: m.getDay()}
+	for index := range m.getShopConf().GetSellGoods() {
+		client.GoodsPrices = append(client.GoodsPrices, m.getGoodsPrice(index, now))
+	}
+	return client
+}
+
+//
+// getGoodsPrice
+// @Description: 获取单个商品当前价格
+// @receiver m
+// @param goodsIndex
+// @param now
+// @return *pb.OperateGoodsPrice
+//
+func (m *shopTemplate) getGoodsPrice(goodsIndex int, now int64) *pb.OperateGoodsPrice {
+	if goodsIndex < 0 || goodsIndex >= len(m.getShopConf().GetSellGoods()) {
+		return nil
+	}
+	goodsConf := m.getShopConf().GetSellGoods()[goodsIndex]
+	discount, saleEndTime := m.getDiscount(goodsConf, now)
+	price := &pb.OperateGoodsPrice{
+		GoodsIndex:  int32(goodsIndex),
+		Expend:      m.getPrice(goodsConf, m.getShopData().GetBuyCounts()[int32(goodsIndex)], now),
+		Discount:    discount,
+		SaleEndTime: saleEndTime,
+	}
+	if goodsConf.GetStock() > 0 {
+		price.RemainStock = getGlobalOperateActivityMgr().remainStock(m.getStockKey(goodsIndex), goodsConf.GetStock())
+	}
+	return price
+}
+
+//
+// resetBuyCount
+// @Description: 按刷新类型重置商品购买次数
+// @receiver m
+// @param resetType
+//
+func (m *shopTemplate) resetBuyCount(resetType pb.TaskRefreshType) {
+	dbData := m.getShopData()
+	if dbData == nil {
+		return
+	}
+	var changed bool
+	for index, goodsConf := range m.getShopConf().GetSellGoods() {
+		if goodsConf.GetRefreshType() != resetType {
+			continue
+		}
+		if _, ok := dbData.GetBuyCounts()[int32(index)]; !ok {
+			continue
+		}
+		delete(dbData.GetBuyCounts(), int32(index))
+		changed = true
+	}
+	if changed {
+		m.saveDB()
+	}
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param player
+// @return *pb.OperateNotifyTemplate
+//
+func (m *shopTemplate) getNotify(player IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{TemplateType: m.getType()}
+	now := nowTimestamp()
+	dbData := m.getShopData()
+	for index, goodsConf := range m.getShopConf().GetSellGoods() {
+		buyCount := dbData.GetBuyCounts()[int32(index)]
+		if m.isLimit(goodsConf, buyCount) {
+			continue
+		}
+		if goodsConf.GetStock() > 0 && getGlobalOperateActivityMgr().remainStock(m.getStockKey(index), goodsConf.GetStock()) <= 0 {
+			continue
+		}
+		if err := player.OperateCheckCost(m.activity.getId(), m.getPrice(goodsConf, buyCount, now)); err != nil {
+			continue
+		}
+		notify.GoodsCount++
+	}
+	return notify
+}
+
 func (m *shopTemplate) saveDB() {
 	m.activity.callUpdateStatusFun(m.generateUpdateData(), DataUpdate)
 }
@@ -9,7 +9,6 @@
 package activity
 
 import (
-	"errors"
 	"github.com/dingqinghui/activity/pb"
 	"go.uber.org/zap"
 	"math"
@@ -155,19 +154,41 @@ func (m *signTemplate) isLoginTrigger() bool {
 	return !conf.GetTriggerCondition()
 }
 
-////
-//// isAutoGetReward
-//// @Description: 是否自动发送签到奖励
-//// @receiver m
-//// @return bool
-////
-//func (m *signTemplate) isAutoGetReward() bool {
-//	conf := m.getSignConf()
-//	if conf == nil {
-//		return false
-//	}
-//	return !conf.GetIsAutoGetReward()
-//}
+//
+// isAutoGetReward
+// @Description: 是否自动发送签到奖励
+// @receiver m
+// @return bool
+//
+func (m *signTemplate) isAutoGetReward() bool {
+	conf := m.getSignConf()
+	if conf == nil {
+		return false
+	}
+	return conf.GetIsAutoGetReward()
+}
+
+//
+// autoGetReward
+// @Description: 自动领奖模式下发放指定天奖励并标记已领取,手动模式不处理
+// @receiver m
+// @param day 签到天数
+// @param source 流水来源
+// @return error
+//
+func (m *signTemplate) autoGetReward(day int32, source LedgerSource) error {
+	if !m.isAutoGetReward() || m.isGotReward(day) {
+		return nil
+	}
+	if reward := m.getDayRewardConf(day); reward != nil {
+		trace := m.newLedgerTrace(source, day)
+		if err := m.activity.mgr.operateAddReward(trace, reward.GetSignInReward()); err != nil {
+			return addRewardFail.with("day", day).wrap(err)
+		}
+	}
+	m.getSignData().GetGots()[day] = true
+	return nil
+}
 
 func (m *signTemplate) canSignDay() int32 {
 	return m.activity.openDay()
@@ -176,19 +197,25 @@ func (m *signTemplate) canSignDay() int32 {
 func (m *signTemplate) sign(player IPlayer) error {
 	dbData := m.getSignData()
 	if dbData == nil {
-		return errors.New("sign db data is nil")
+		return dbError.with("activityId", m.activity.getId())
 	}
 	// 检测签到条件
 	if err := m.checkSignCondition(player); err != nil {
 		return err
 	}
+	// 连续签到模式签到即发放奖励
+	if m.isStreakMode() {
+		return m.streakSign(player)
+	}
+	if m.isCalendarMode() {
+		return m.calendarSign(player)
+	}
 
-	//// 自动下发奖励
-	//if m.getSignConf().GetIsAutoGetReward() {
-	//	if err := m.addSignReward(player); err != nil {
-	//		return err
-	//	}
-	//}
+	// 自动下发奖励
+	if err := m.autoGetReward(dbData.GetSignedDay()+1, LedgerSourceSignReward); err != nil {
+		logError("签到失败，添加奖励失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		return err
+	}
 	// 签到
 	dbData.SignedDay += 1
 	dbData.LastSignTimestamp = nowTimestamp()
@@ -222,26 +249,36 @@ func (m *signTemplate) getReward(player IPlayer, day int32) error {
 
 	dbData := m.getSignData()
 	if dbData == nil {
-		return errors.New("sign db data is nil")
+		return dbError.with("activityId", m.activity.getId())
+	}
+
+	// 连续签到奖励在签到时发放
+	if m.isStreakMode() {
+		return signModeError.with("mode", m.getSignConf().GetMode())
 	}
 
 	// 没有签到
-	if day > dbData.GetSignedDay() {
-		return errors.New("not signed")
+	if m.isCalendarMode() {
+		if !m.isSignedDate(day) {
+			return signNotSigned.with("date", day)
+		}
+	} else if day <= 0 || day > dbData.GetSignedDay() {
+		return signNotSigned.with("day", day).with("signedDay", dbData.GetSignedDay())
 	}
 
 	// 检测是否已领取奖励
 	if m.isGotReward(day) {
-		return errors.New("sign reward got")
+		return signRewardGot.with("day", day)
 	}
 
 	// 标记已领取
 	m.getSignData().GetGots()[day] = true
 
 	// 下发奖励
-	reward := m.getSignRewardConfByDay(day)
-	if err := player.OperateAddReward(m.activity.getId(), reward.GetSignInReward()); err != nil {
-		return errors.New("sign add reward fail ")
+	reward := m.getDayRewardConf(day)
+	trace := m.newLedgerTrace(LedgerSourceSignReward, day)
+	if err := m.activity.mgr.operateAddReward(trace, reward.GetSignInReward()); err != nil {
+		return addRewardFail.with("day", day).wrap(err)
 	}
 	return nil
 }
@@ -251,49 +288,97 @@ func (m *signTemplate) getSignRewardConfByDay(day int32) *pb.SignInReward {
 	if conf == nil {
 		return nil
 	}
-	if int(day) > len(conf.GetRewardList()) {
+	if day <= 0 || int(day) > len(conf.GetRewardList()) {
 		return nil
 	}
 	return conf.GetRewardList()[day-1]
 }
 
+//
+// getDayRewardConf
+// @Description: 获取签到奖励配置,月历签到按日期中的日对应奖励
+// @receiver m
+// @param day 签到天数,月历签到为日期(yyyymmdd)
+// @return *pb.SignInReward
+//
+func (m *signTemplate) getDayRewardConf(day int32) *pb.SignInReward {
+	if m.isCalendarMode() {
+		return m.getSignRewardConfByDay(day % 100)
+	}
+	return m.getSignRewardConfByDay(day)
+}
+
 func (m *signTemplate) checkSignCondition(player IPlayer) error {
+	if err := m.canSign(); err != nil {
+		logError("签到条件不满足", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+//
+// canSign
+// @Description: 今日是否可签到
+// @receiver m
+// @return error nil:可签到
+//
+func (m *signTemplate) canSign() error {
 	conf := m.getSignConf()
 	if conf == nil {
-		return errors.New("sign conf is nil")
+		return confError.with("activityId", m.activity.getId())
 	}
 	dbData := m.getSignData()
 	if dbData == nil {
-		return errors.New("sign db data is nil")
+		return dbError.with("activityId", m.activity.getId())
 	}
 
 	if !isDifferDay(nowTimestamp(), dbData.GetLastSignTimestamp()) {
-		logError("今日已签到", zap.Int32("playerId", player.GetId()))
-		return errors.New("today signed")
+		return signTodaySigned.with("lastSignTimestamp", dbData.GetLastSignTimestamp())
+	}
+
+	// 连续签到和月历签到不限制签到次数
+	if m.isStreakMode() || m.isCalendarMode() {
+		return nil
 	}
 
 	if dbData.GetSignedDay() >= m.getCanSignCount() {
-		return errors.New("sign count limit")
+		return signCountLimit.with("signedDay", dbData.GetSignedDay()).with("canSignCount", m.getCanSignCount())
 	}
 	return nil
 }
 
-func (m *signTemplate) repair(player IPlayer) error {
-	if err := m.repairCondition(player); err != nil {
-		logError("补签失败，条件检测失败", zap.Int32("playerId", player.GetId()))
-		return errors.New("repair sign condition ")
+//
+// repair
+// @Description: 补签
+// @receiver m
+// @param player
+// @param date 月历签到补签日期(yyyymmdd),其他模式忽略
+// @return error
+//
+func (m *signTemplate) repair(player IPlayer, date int32) error {
+	// 连续签到通过保护道具处理断签,不支持补签
+	if m.isStreakMode() {
+		return signModeError.with("mode", m.getSignConf().GetMode())
 	}
-
-	if err := m.addSignReward(player); err != nil {
+	dbData := m.getSignData()
+	if dbData == nil {
+		return dbError.with("activityId", m.activity.getId())
+	}
+	if m.isCalendarMode() {
+		return m.calendarRepair(player, date)
+	}
+	if err := m.repairCondition(player, dbData.GetSignedDay(), dbData.GetSignedDay()+1); err != nil {
+		logError("补签失败，条件检测失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		return err
+	}
+	// 自动领奖模式下发奖励,手动模式通过SignGetReward领取
+	if err := m.autoGetReward(dbData.GetSignedDay()+1, LedgerSourceSignRepair); err != nil {
 		logError("补签失败，添加奖励失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		m.refundRepairCost(dbData.GetSignedDay(), dbData.GetSignedDay()+1)
 		return err
 	}
 
 	// 签到
-	dbData := m.getSignData()
-	if dbData == nil {
-		return errors.New("sign db data is nil")
-	}
 	dbData.SignedDay += 1
 	dbData.RepairCount += 1
 	dbData.EveryDayRepairCount += 1
@@ -334,38 +419,6 @@ func (m *signTemplate) getCantRepairCount() int32 {
 	return int32(math.Min(float64(m.canSignDay()-1), float64(conf.GetRepairSignInCount())))
 }
 
-//
-// addSignReward
-// @Description: 添加签到奖励
-// @receiver m
-//
-func (m *signTemplate) addSignReward(player IPlayer) error {
-	conf := m.getSignConf()
-	if conf == nil {
-		return errors.New("sign conf is nil")
-	}
-	dbData := m.getSignData()
-	if dbData == nil {
-		return errors.New("sign db data is nil")
-	}
-
-	// 下发奖励
-	rewards := conf.GetRewardList()
-	if int(dbData.GetSignedDay()) < len(rewards) {
-		reward := rewards[dbData.GetSignedDay()]
-		if err := player.OperateAddReward(m.activity.getId(), reward.GetSignInReward()); err != nil {
-			logError("签到失败",
-				zap.Int32("playerId", player.GetId()),
-				zap.Int64("activityId", m.activity.getId()),
-				zap.Int32("signedDay", dbData.GetSignedDay()),
-				zap.Error(err),
-			)
-			return errors.New("repair sign add reward fail ")
-		}
-	}
-	return nil
-}
-
 func (m *signTemplate) resetEveryDayRepairCount() {
 	if m.getSignData() == nil {
 		return
@@ -374,52 +427,76 @@ func (m *signTemplate) resetEveryDayRepairCount() {
 	m.saveDB()
 }
 
-func (m *signTemplate) repairCondition(player IPlayer) error {
+//
+// refundRepairCost
+// @Description: 补签奖励发放失败时退还repairCondition扣除的补签消耗
+// @receiver m
+// @param ruleIndex 补签规则索引
+// @param day 补签天数,月历签到为日期(yyyymmdd)
+//
+func (m *signTemplate) refundRepairCost(ruleIndex int32, day int32) {
+	rules := m.getSignConf().GetRepairSignIn()
+	if int(ruleIndex) >= len(rules) || rules[ruleIndex].GetRSI_Expend() == nil {
+		return
+	}
+	trace := m.newLedgerTrace(LedgerSourceSignRepair, day)
+	m.activity.mgr.refundCost(trace, rules[ruleIndex].GetRSI_Expend())
+}
+
+//
+// repairCondition
+// @Description: 检测补签条件并扣除补签消耗
+// @receiver m
+// @param player
+// @param ruleIndex 补签规则索引
+// @param day 补签天数,月历签到为日期(yyyymmdd)
+// @return error
+//
+func (m *signTemplate) repairCondition(player IPlayer, ruleIndex int32, day int32) error {
 	conf := m.getSignConf()
 	if conf == nil {
-		return errors.New("sign conf is nil")
+		return confError.with("activityId", m.activity.getId())
 	}
 	dbData := m.getSignData()
 	if dbData == nil {
-		return errors.New("sign db data is nil")
+		return dbError.with("activityId", m.activity.getId())
 	}
 
 	// 已补签次数 > 可补签次数
 	if dbData.GetRepairCount() >= m.getCantRepairCount() {
-		return errors.New("repair sign count limit")
+		return repairCountLimit.with("repairCount", dbData.GetRepairCount())
 	}
 
 	// 每日已补签次数 >= 每日可补签次数
 	if dbData.GetEveryDayRepairCount() >= m.getSignConf().GetEveryDayRepairSignInCount() {
-		return errors.New("every day repair sign count limit")
+		return repairDayCountLimit.with("everyDayRepairCount", dbData.GetEveryDayRepairCount())
 	}
 
-	// 已签到天数
-	signedDay := dbData.GetSignedDay()
 	// 补签条件
 	rules := conf.GetRepairSignIn()
 	// 无条件
-	if int(signedDay) >= len(rules) {
+	if int(ruleIndex) >= len(rules) {
 		return nil
 	}
 	// 检测补签条件
-	rule := rules[signedDay]
+	rule := rules[ruleIndex]
 	// 道具消耗补签
 	if rule.GetRSI_Expend() != nil {
-		if err := player.OperateSubCost(m.activity.getId(), rule.GetRSI_Expend()); err != nil {
-			logError("补签失败，道具不足", zap.Int32("playerId", player.GetId()), zap.Int32("signedDay", signedDay))
-			return errors.New("repair condition not enough expend")
+		trace := m.newLedgerTrace(LedgerSourceSignRepair, day)
+		if err := m.activity.mgr.operateSubCost(trace, rule.GetRSI_Expend()); err != nil {
+			logError("补签失败，道具不足", zap.Int32("playerId", player.GetId()), zap.Int32("ruleIndex", ruleIndex))
+			return costNotEnough.with("ruleIndex", ruleIndex).wrap(err)
 		}
 		return nil
 	}
 
 	// 无条件
-	if int(signedDay) >= len(dbData.GetConditions()) {
+	if int(ruleIndex) >= len(dbData.GetConditions()) {
 		return nil
 	}
 
 	// 检测补签条件
-	condition := dbData.GetConditions()[signedDay]
+	condition := dbData.GetConditions()[ruleIndex]
 	if condition == nil {
 		return nil
 	}
@@ -427,8 +504,8 @@ func (m *signTemplate) repairCondition(player IPlayer) error {
 	if rule.GetRSI_Condition() != nil {
 		for _, task := range condition.GetTasks() {
 			if task.GetTaskState() == pb.OperateTaskState_OTS_Doing {
-				logError("补签失败，条件不满足", zap.Int32("playerId", player.GetId()), zap.Int32("signedDay", signedDay))
-				return errors.New("repair condition task not finish")
+				logError("补签失败，条件不满足", zap.Int32("playerId", player.GetId()), zap.Int32("ruleIndex", ruleIndex))
+				return repairTaskNotFinish.with("ruleIndex", ruleIndex)
 			}
 		}
 	}
@@ -442,15 +519,83 @@ func (m *signTemplate) repairCondition(player IPlayer) error {
 // @return []*pb.ItemData
 //
 func (m *signTemplate) getCanReceiveReward() []*pb.ItemData {
-	max := m.getSignData().GetSignedDay()
 	var rewards []*pb.ItemData
-	for day := int32(0); day <= max; day++ {
+	for _, day := range m.getCanReceiveDays() {
+		reward := m.getDayRewardConf(day)
+		rewards = append(rewards, reward.GetSignInReward()...)
+	}
+	return rewards
+}
+
+//
+// getCanReceiveDays
+// @Description: 获取已签到未领奖的天数
+// @receiver m
+// @return []int32
+//
+func (m *signTemplate) getCanReceiveDays() []int32 {
+	// 连续签到奖励在签到时发放
+	if m.isStreakMode() {
+		return nil
+	}
+	if m.isCalendarMode() {
+		var dates []int32
+		for _, date := range m.getSignData().GetSignedDates() {
+			if !m.isGotReward(date) {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+	max := m.getSignData().GetSignedDay()
+	var days []int32
+	for day := int32(1); day <= max; day++ {
 		// 已领取
 		if m.isGotReward(day) {
 			continue
 		}
-		reward := m.getSignRewardConfByDay(day)
-		rewards = append(rewards, reward.GetSignInReward()...)
+		days = append(days, day)
 	}
-	return rewards
+	return days
+}
+
+//
+// getClientData
+// @Description: 获取连续签到当前连续天数/月历签到今天日期
+// @receiver m
+// @return *pb.OperateTemplateClient
+//
+func (m *signTemplate) getClientData() *pb.OperateTemplateClient {
+	switch {
+	case m.isStreakMode():
+		return &pb.OperateTemplateClient{
+			Day:    m.getDay(),
+			Streak: m.getCurrentStreak(nowTimestamp()),
+		}
+	case m.isCalendarMode():
+		return &pb.OperateTemplateClient{
+			Day:   m.getDay(),
+			Today: calendarDate(nowTimestamp()),
+		}
+	}
+	return nil
+}
+
+//
+// getNotify
+// @Description: 获取红点信息
+// @receiver m
+// @param player
+// @return *pb.OperateNotifyTemplate
+//
+func (m *signTemplate) getNotify(player IPlayer) *pb.OperateNotifyTemplate {
+	notify := &pb.OperateNotifyTemplate{
+		TemplateType:    m.getType(),
+		SignRewardCount: int32(len(m.getCanReceiveDays())),
+	}
+	// 登录触发的签到无需玩家操作
+	if !m.isLoginTrigger() {
+		notify.CanSign = m.canSign() == nil
+	}
+	return notify
 }
@@ -0,0 +1,148 @@
+/**
+ * @Author: dingqinghui
+ * @Description:月历签到
+ * @File:  player_template_sign_calendar
+ * @Version: 1.0.0
+ * @Date: 2022/8/23 11:26
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+	"time"
+)
+
+//
+// calendarDate
+// @Description: 时间戳转日期(yyyymmdd),按配置时区和每日刷新时间计算
+// @param timestamp
+// @return int32
+//
+func calendarDate(timestamp int64) int32 {
+	t := time.Unix(timestamp+int64((timeZero-everydayUpdateHour)*3600), 0).UTC()
+	return int32(t.Year()*10000 + int(t.Month())*100 + t.Day())
+}
+
+//
+// isValidDate
+// @Description: 日期(yyyymmdd)是否合法
+// @param date
+// @return bool
+//
+func isValidDate(date int32) bool {
+	year, month, day := int(date/10000), time.Month(date/100%100), int(date%100)
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return t.Year() == year && t.Month() == month && t.Day() == day
+}
+
+//
+// isCalendarMode
+// @Description: 是否月历签到模式
+// @receiver m
+// @return bool
+//
+func (m *signTemplate) isCalendarMode() bool {
+	return m.getSignConf().GetMode() == pb.SignInMode_SIM_CALENDAR
+}
+
+//
+// isSignedDate
+// @Description: 日期是否已签到
+// @receiver m
+// @param date
+// @return bool
+//
+func (m *signTemplate) isSignedDate(date int32) bool {
+	for _, signed := range m.getSignData().GetSignedDates() {
+		if signed == date {
+			return true
+		}
+	}
+	return false
+}
+
+//
+// calendarSign
+// @Description: 月历签到,记录今天日期
+// @receiver m
+// @param player
+// @return error
+//
+func (m *signTemplate) calendarSign(player IPlayer) error {
+	now := nowTimestamp()
+	date := calendarDate(now)
+	if m.isSignedDate(date) {
+		return signTodaySigned.with("date", date)
+	}
+	// 自动下发奖励
+	if err := m.autoGetReward(date, LedgerSourceSignReward); err != nil {
+		logError("签到失败，添加奖励失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		return err
+	}
+
+	dbData := m.getSignData()
+	dbData.SignedDates = append(dbData.SignedDates, date)
+	dbData.SignedDay += 1
+	dbData.LastSignTimestamp = now
+	m.saveDB()
+	logInfo("月历签到成功", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()), zap.Int32("date", date))
+	return nil
+}
+
+//
+// checkRepairDate
+// @Description: 检测补签日期,只能补签活动开启后本月今天之前未签到的日期
+// @receiver m
+// @param date
+// @param now
+// @return error
+//
+func (m *signTemplate) checkRepairDate(date int32, now int64) error {
+	today := calendarDate(now)
+	if !isValidDate(date) || date >= today || date/100 != today/100 {
+		return signDateError.with("date", date).with("today", today)
+	}
+	if startTime := m.activity.getStartTime(); date < calendarDate(startTime) {
+		return signDateError.with("date", date).with("startTime", startTime)
+	}
+	if m.isSignedDate(date) {
+		return signDateSigned.with("date", date)
+	}
+	return nil
+}
+
+//
+// calendarRepair
+// @Description: 月历补签指定日期
+// @receiver m
+// @param player
+// @param date 补签日期(yyyymmdd)
+// @return error
+//
+func (m *signTemplate) calendarRepair(player IPlayer, date int32) error {
+	if err := m.checkRepairDate(date, nowTimestamp()); err != nil {
+		return err
+	}
+	dbData := m.getSignData()
+	if err := m.repairCondition(player, dbData.GetRepairCount(), date); err != nil {
+		logError("补签失败，条件检测失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		return err
+	}
+	// 自动领奖模式下发奖励,手动模式通过SignGetReward领取
+	if err := m.autoGetReward(date, LedgerSourceSignRepair); err != nil {
+		logError("补签失败，添加奖励失败", zap.Int32("playerId", player.GetId()), zap.Error(err))
+		m.refundRepairCost(dbData.GetRepairCount(), date)
+		return err
+	}
+
+	dbData.SignedDates = append(dbData.SignedDates, date)
+	dbData.SignedDay += 1
+	dbData.RepairCount += 1
+	dbData.EveryDayRepairCount += 1
+	m.saveDB()
+	logInfo("月历补签成功", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("date", date), zap.Int32("repairCount", dbData.GetRepairCount()))
+	return nil
+}
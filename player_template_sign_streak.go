@@ -0,0 +1,184 @@
+/**
+ * @Author: dingqinghui
+ * @Description:连续签到
+ * @File:  player_template_sign_streak
+ * @Version: 1.0.0
+ * @Date: 2022/8/22 15:08
+ */
+
+package activity
+
+import (
+	"github.com/dingqinghui/activity/pb"
+	"go.uber.org/zap"
+	"sort"
+)
+
+//
+// isStreakMode
+// @Description: 是否连续签到模式
+// @receiver m
+// @return bool
+//
+func (m *signTemplate) isStreakMode() bool {
+	return m.getSignConf().GetMode() == pb.SignInMode_SIM_STREAK
+}
+
+//
+// getBreakDays
+// @Description: 获取超出宽限天数的断签天数
+// @receiver m
+// @param now
+// @return int32
+//
+func (m *signTemplate) getBreakDays(now int64) int32 {
+	last := m.getSignData().GetLastSignTimestamp()
+	if last <= 0 {
+		return 0
+	}
+	// 上次签到到今天之间未签到的天数
+	missed := int32(diffDayNum(now, last)) - 1
+	breakDays := missed - m.getSignConf().GetStreakGraceDays()
+	if breakDays < 0 {
+		return 0
+	}
+	return breakDays
+}
+
+//
+// decayStreak
+// @Description: 计算断签后的连续天数
+// @receiver m
+// @param streak 断签前连续天数
+// @param breakDays 断签天数
+// @return int32
+//
+func (m *signTemplate) decayStreak(streak int32, breakDays int32) int32 {
+	if breakDays <= 0 {
+		return streak
+	}
+	decay := m.getSignConf().GetStreakDecay()
+	if decay <= 0 {
+		return 0
+	}
+	streak -= decay * breakDays
+	if streak < 0 {
+		return 0
+	}
+	return streak
+}
+
+//
+// getCurrentStreak
+// @Description: 获取当前连续天数,已按断签规则计算,不计算保护道具
+// @receiver m
+// @param now
+// @return int32
+//
+func (m *signTemplate) getCurrentStreak(now int64) int32 {
+	return m.decayStreak(m.getSignData().GetStreak(), m.getBreakDays(now))
+}
+
+//
+// useStreakFreeze
+// @Description: 断签时消耗保护道具,每个道具抵消一天断签。断签清零时需保护全部断签天数,道具不足时不消耗;按天扣减时按持有数量尽量保护
+// @receiver m
+// @param player
+// @param breakDays 断签天数
+// @return int32 保护后的断签天数
+// @return func() 签到奖励发放失败时调用,退还已消耗的保护道具
+//
+func (m *signTemplate) useStreakFreeze(player IPlayer, breakDays int32) (int32, func()) {
+	noRefund := func() {}
+	itemId := m.getSignConf().GetStreakFreezeItemId()
+	if breakDays <= 0 || itemId <= 0 {
+		return breakDays, noRefund
+	}
+	use := breakDays
+	if m.getSignConf().GetStreakDecay() > 0 {
+		use = m.getFreezeCount(player, itemId, breakDays)
+	}
+	cost := []*pb.ItemData{{Id: itemId, Num: use}}
+	if use <= 0 || player.OperateCheckCost(m.activity.getId(), cost) != nil {
+		return breakDays, noRefund
+	}
+	trace := m.newLedgerTrace(LedgerSourceStreakFreeze, m.getSignData().GetStreak())
+	if err := m.activity.mgr.operateSubCost(trace, cost); err != nil {
+		logError("扣除断签保护道具失败", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()), zap.Error(err))
+		return breakDays, noRefund
+	}
+	return breakDays - use, func() {
+		m.activity.mgr.refundCost(trace, cost)
+	}
+}
+
+//
+// getFreezeCount
+// @Description: 获取可使用的保护道具数量
+// @receiver m
+// @param player
+// @param itemId 保护道具Id
+// @param max 最多使用数量
+// @return int32
+//
+func (m *signTemplate) getFreezeCount(player IPlayer, itemId int32, max int32) int32 {
+	return int32(sort.Search(int(max), func(i int) bool {
+		return player.OperateCheckCost(m.activity.getId(), []*pb.ItemData{{Id: itemId, Num: int32(i + 1)}}) != nil
+	}))
+}
+
+//
+// getStreakRewardConf
+// @Description: 根据连续天数获取奖励配置,超出奖励列表时循环或使用最后一天奖励
+// @receiver m
+// @param streak
+// @return *pb.SignInReward
+//
+func (m *signTemplate) getStreakRewardConf(streak int32) *pb.SignInReward {
+	rewards := m.getSignConf().GetRewardList()
+	if streak <= 0 || len(rewards) <= 0 {
+		return nil
+	}
+	index := int(streak - 1)
+	if index >= len(rewards) {
+		if m.getSignConf().GetStreakCycle() {
+			index %= len(rewards)
+		} else {
+			index = len(rewards) - 1
+		}
+	}
+	return rewards[index]
+}
+
+//
+// streakSign
+// @Description: 连续签到,签到时按连续天数发放奖励
+// @receiver m
+// @param player
+// @return error
+//
+func (m *signTemplate) streakSign(player IPlayer) error {
+	now := nowTimestamp()
+	dbData := m.getSignData()
+	breakDays, refundFreeze := m.useStreakFreeze(player, m.getBreakDays(now))
+	streak := m.decayStreak(dbData.GetStreak(), breakDays) + 1
+
+	// 下发奖励
+	reward := m.getStreakRewardConf(streak)
+	trace := m.newLedgerTrace(LedgerSourceSignReward, streak)
+	if err := m.activity.mgr.operateAddReward(trace, reward.GetSignInReward()); err != nil {
+		refundFreeze()
+		return addRewardFail.with("streak", streak).wrap(err)
+	}
+
+	dbData.Streak = streak
+	if streak > dbData.GetMaxStreak() {
+		dbData.MaxStreak = streak
+	}
+	dbData.SignedDay += 1
+	dbData.LastSignTimestamp = now
+	m.saveDB()
+	logInfo("连续签到成功", zap.Int32("playerId", player.GetId()), zap.Int64("activityId", m.activity.getId()),
+		zap.Int32("streak", streak), zap.Int32("breakDays", breakDays))
+	return nil
+}
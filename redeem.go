@@ -0,0 +1,332 @@
+/**
+ * @Author: dingqinghui
+ * @Description:兑换码
+ * @File:  redeem
+ * @Version: 1.0.0
+ * @Date: 2022/9/5 10:40
+ */
+
+package activity
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"github.com/dingqinghui/activity/pb"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+)
+
+//
+// RedeemBatch
+// @Description: 兑换码批次,同一批次的兑换码共用奖励和限制
+//
+type RedeemBatch struct {
+	BatchId     string         `json:"batchId"`     // 批次Id
+	ActivityId  int64          `json:"activityId"`  // 所属活动,不为0时只能在玩家该活动进行中兑换
+	StartTime   int64          `json:"startTime"`   // 有效期开始时间(s),0:不限制
+	EndTime     int64          `json:"endTime"`     // 有效期结束时间(s),0:不限制
+	Universal   bool           `json:"universal"`   // 是否通用码,通用码所有玩家共用一个码
+	CodeLimit   int32          `json:"codeLimit"`   // 每个码可使用的总次数,0:生成码默认1次,通用码不限制
+	PlayerLimit int32          `json:"playerLimit"` // 每个玩家在该批次可兑换的次数,0:默认1次
+	Channels    []int32        `json:"channels"`    // 可兑换渠道,为空时不限制
+	Areas       []int32        `json:"areas"`       // 可兑换区服,为空时不限制
+	Reward      []*pb.ItemData `json:"reward"`      // 奖励
+}
+
+//
+// getCodeLimit
+// @Description: 每个码可使用的总次数
+// @receiver m
+// @return int32 0:不限制
+//
+func (m *RedeemBatch) getCodeLimit() int32 {
+	if m.CodeLimit <= 0 && !m.Universal {
+		return 1
+	}
+	return m.CodeLimit
+}
+
+//
+// getPlayerLimit
+// @Description: 每个玩家在该批次可兑换的次数
+// @receiver m
+// @return int32
+//
+func (m *RedeemBatch) getPlayerLimit() int32 {
+	if m.PlayerLimit <= 0 {
+		return 1
+	}
+	return m.PlayerLimit
+}
+
+//
+// RedeemUseResult
+// @Description: 兑换码使用结果
+//
+type RedeemUseResult int
+
+const (
+	// RedeemUseOk 使用成功
+	RedeemUseOk RedeemUseResult = iota
+	// RedeemUseCodeLimit 兑换码使用次数已用完
+	RedeemUseCodeLimit
+	// RedeemUsePlayerLimit 玩家兑换次数已达上限
+	RedeemUsePlayerLimit
+)
+
+//
+// RedeemCodeStore
+// @Description: 兑换码存储,Use需保证原子性,多进程部署时可替换为redis等共享存储
+//
+type RedeemCodeStore interface {
+	// AddBatch 添加批次和批次下的兑换码,批次Id或兑换码已存在时返回错误
+	AddBatch(batch *RedeemBatch, codes []string) error
+	// GetBatch 获取兑换码所属批次,不存在返回nil
+	GetBatch(code string) (*RedeemBatch, error)
+	// Use 使用兑换码,兑换码和玩家使用次数均未达上限时增加使用次数
+	Use(code string, playerId int32) (RedeemUseResult, error)
+	// Revert 撤销一次使用,发放奖励失败时调用
+	Revert(code string, playerId int32) error
+}
+
+//
+// normalizeRedeemCode
+// @Description: 兑换码去除首尾空格并转大写
+// @param code
+// @return string
+//
+func normalizeRedeemCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// redeemCodeAlphabet 生成兑换码字符集,去掉易混淆的0/O/1/I
+const redeemCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+//
+// randomRedeemCode
+// @Description: 生成随机兑换码
+// @param length
+// @return string
+// @return error
+//
+func randomRedeemCode(length int) (string, error) {
+	var builder strings.Builder
+	max := big.NewInt(int64(len(redeemCodeAlphabet)))
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteByte(redeemCodeAlphabet[n.Int64()])
+	}
+	return builder.String(), nil
+}
+
+//
+// generateRedeemCodes
+// @Description: 生成count个不重复的兑换码
+// @param count
+// @param length
+// @return []string
+// @return error
+//
+func generateRedeemCodes(count int, length int) ([]string, error) {
+	if count <= 0 || length < 6 {
+		return nil, fmt.Errorf("invalid redeem code count %d length %d", count, length)
+	}
+	codes := make([]string, 0, count)
+	exist := make(map[string]bool, count)
+	for len(codes) < count {
+		code, err := randomRedeemCode(length)
+		if err != nil {
+			return nil, err
+		}
+		if exist[code] {
+			continue
+		}
+		exist[code] = true
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+//
+// redeemStoreData
+// @Description: 兑换码存储数据
+//
+type redeemStoreData struct {
+	Batches    map[string]*RedeemBatch `json:"batches"`    // key:批次Id
+	Codes      map[string]string       `json:"codes"`      // key:兑换码 value:批次Id
+	CodeUses   map[string]int32        `json:"codeUses"`   // key:兑换码 value:已使用次数
+	PlayerUses map[string]int32        `json:"playerUses"` // key:批次Id:玩家Id value:已兑换次数
+}
+
+func newRedeemStoreData() *redeemStoreData {
+	return &redeemStoreData{
+		Batches:    make(map[string]*RedeemBatch),
+		Codes:      make(map[string]string),
+		CodeUses:   make(map[string]int32),
+		PlayerUses: make(map[string]int32),
+	}
+}
+
+func redeemPlayerKey(batchId string, playerId int32) string {
+	return fmt.Sprintf("%s:%d", batchId, playerId)
+}
+
+//
+// MemoryRedeemStore
+// @Description: 进程内兑换码存储
+//
+type MemoryRedeemStore struct {
+	sync.Mutex
+	data *redeemStoreData
+}
+
+func NewMemoryRedeemStore() *MemoryRedeemStore {
+	return &MemoryRedeemStore{data: newRedeemStoreData()}
+}
+
+func (m *MemoryRedeemStore) AddBatch(batch *RedeemBatch, codes []string) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.data.Batches[batch.BatchId]; ok {
+		return fmt.Errorf("redeem batch %s exist", batch.BatchId)
+	}
+	for _, code := range codes {
+		if _, ok := m.data.Codes[code]; ok {
+			return fmt.Errorf("redeem code %s exist", code)
+		}
+	}
+	m.data.Batches[batch.BatchId] = batch
+	for _, code := range codes {
+		m.data.Codes[code] = batch.BatchId
+	}
+	return nil
+}
+
+func (m *MemoryRedeemStore) GetBatch(code string) (*RedeemBatch, error) {
+	m.Lock()
+	defer m.Unlock()
+	batchId, ok := m.data.Codes[code]
+	if !ok {
+		return nil, nil
+	}
+	return m.data.Batches[batchId], nil
+}
+
+func (m *MemoryRedeemStore) Use(code string, playerId int32) (RedeemUseResult, error) {
+	m.Lock()
+	defer m.Unlock()
+	batch := m.data.Batches[m.data.Codes[code]]
+	if batch == nil {
+		return RedeemUseCodeLimit, fmt.Errorf("redeem code %s not exist", code)
+	}
+	if limit := batch.getCodeLimit(); limit > 0 && m.data.CodeUses[code] >= limit {
+		return RedeemUseCodeLimit, nil
+	}
+	playerKey := redeemPlayerKey(batch.BatchId, playerId)
+	if m.data.PlayerUses[playerKey] >= batch.getPlayerLimit() {
+		return RedeemUsePlayerLimit, nil
+	}
+	m.data.CodeUses[code]++
+	m.data.PlayerUses[playerKey]++
+	return RedeemUseOk, nil
+}
+
+func (m *MemoryRedeemStore) Revert(code string, playerId int32) error {
+	m.Lock()
+	defer m.Unlock()
+	batch := m.data.Batches[m.data.Codes[code]]
+	if batch == nil {
+		return fmt.Errorf("redeem code %s not exist", code)
+	}
+	if m.data.CodeUses[code] > 0 {
+		m.data.CodeUses[code]--
+	}
+	if playerKey := redeemPlayerKey(batch.BatchId, playerId); m.data.PlayerUses[playerKey] > 0 {
+		m.data.PlayerUses[playerKey]--
+	}
+	return nil
+}
+
+//
+// FileRedeemStore
+// @Description: 文件兑换码存储,每次修改后将全部数据以json写入文件,适合单进程少量兑换码
+//
+type FileRedeemStore struct {
+	sync.Mutex
+	*MemoryRedeemStore
+	path string
+}
+
+func NewFileRedeemStore(path string) (*FileRedeemStore, error) {
+	store := &FileRedeemStore{MemoryRedeemStore: NewMemoryRedeemStore(), path: path}
+	buf, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(buf, store.data); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+//
+// save
+// @Description: 先写临时文件再重命名,避免写入中途失败损坏数据
+// @receiver m
+// @return error
+//
+func (m *FileRedeemStore) save() error {
+	m.MemoryRedeemStore.Lock()
+	buf, err := json.Marshal(m.data)
+	m.MemoryRedeemStore.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err = os.WriteFile(tmp, buf, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
+
+func (m *FileRedeemStore) AddBatch(batch *RedeemBatch, codes []string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.MemoryRedeemStore.AddBatch(batch, codes); err != nil {
+		return err
+	}
+	return m.save()
+}
+
+func (m *FileRedeemStore) Use(code string, playerId int32) (RedeemUseResult, error) {
+	m.Lock()
+	defer m.Unlock()
+	result, err := m.MemoryRedeemStore.Use(code, playerId)
+	if err != nil || result != RedeemUseOk {
+		return result, err
+	}
+	if err = m.save(); err != nil {
+		// 未持久化的使用记录撤销,避免重启后次数不一致
+		_ = m.MemoryRedeemStore.Revert(code, playerId)
+		return result, err
+	}
+	return result, nil
+}
+
+func (m *FileRedeemStore) Revert(code string, playerId int32) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.MemoryRedeemStore.Revert(code, playerId); err != nil {
+		return err
+	}
+	return m.save()
+}
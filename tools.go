@@ -10,6 +10,8 @@ package activity
 
 import (
 	"github.com/golang/protobuf/proto"
+	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -61,3 +63,85 @@ func deepCopy(src proto.Message, des proto.Message) error {
 	}
 	return nil
 }
+
+var (
+	randMu  sync.Mutex
+	randGen = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+//
+// randIntn
+// @Description: 并发安全的随机数[0,n)
+// @param n
+// @return int
+//
+func randIntn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	randMu.Lock()
+	defer randMu.Unlock()
+	return randGen.Intn(n)
+}
+
+//
+// applyDiscount
+// @Description: 计算折扣后数量,向上取整
+// @param num 原始数量
+// @param discount 折扣百分比(80表示8折),0或>=100不打折
+// @return int32
+//
+func applyDiscount(num int32, discount int32) int32 {
+	if discount = normalizeDiscount(discount); discount == 0 {
+		return num
+	}
+	return applyPercent(num, discount)
+}
+
+//
+// normalizeDiscount
+// @Description: 规范化折扣,0或>=100视为不打折返回0
+// @param discount
+// @return int32
+//
+func normalizeDiscount(discount int32) int32 {
+	if discount <= 0 || discount >= 100 {
+		return 0
+	}
+	return discount
+}
+
+//
+// applyPercent
+// @Description: 按百分比依次计算数量,最后统一向上取整,避免多次取整误差
+// @param num 原始数量
+// @param percents 百分比列表
+// @return int32
+//
+func applyPercent(num int32, percents ...int32) int32 {
+	numerator, denominator := int64(num), int64(1)
+	for _, percent := range percents {
+		numerator *= int64(percent)
+		denominator *= 100
+	}
+	if numerator <= 0 {
+		return 0
+	}
+	return int32((numerator + denominator - 1) / denominator)
+}
+
+//
+// containsInt32
+// @Description: 列表中是否包含指定值
+// @param list
+// @param value
+// @return bool
+//
+func containsInt32(list []int32, value int32) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}